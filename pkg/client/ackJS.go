@@ -0,0 +1,71 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type settleFunc func(ctx context.Context, in *pb.AckMsg, opts ...grpc.CallOption) (*pb.AckMsgResponse, error)
+
+// Ack tells JetStream that the documents with ackToken were processed.
+// It returns once JetStream confirmed it.
+func (sc *SC) Ack(ctx context.Context, ackToken string) error {
+
+	return sc.settle(ctx, "Ack", sc.Client.Ack, ackToken, 0)
+}
+
+// Nak has the documents delivered again after delay.
+func (sc *SC) Nak(ctx context.Context, ackToken string, delay time.Duration) error {
+
+	return sc.settle(ctx, "Nak", sc.Client.Nak, ackToken, delay)
+}
+
+// InProgress asks for more time to process the documents, before
+// JetStream delivers them again.
+func (sc *SC) InProgress(ctx context.Context, ackToken string) error {
+
+	return sc.settle(ctx, "InProgress", sc.Client.InProgress, ackToken, 0)
+}
+
+// Term makes sure the documents are never delivered again,
+// for example because they cannot be processed.
+func (sc *SC) Term(ctx context.Context, ackToken string) error {
+
+	return sc.settle(ctx, "Term", sc.Client.Term, ackToken, 0)
+}
+
+func (sc *SC) settle(ctx context.Context, kind string, f settleFunc,
+	ackToken string, nakDelay time.Duration) error {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_ACK)
+
+	ackMsg := pb.AckMsg{
+		Header:   header,
+		AckToken: ackToken,
+	}
+
+	if nakDelay > 0 {
+		ackMsg.NakDelay = durationpb.New(nakDelay)
+	}
+
+	ackRsp, err := f(ctx, &ackMsg)
+	sc.Logger.Log("%s message sent:\n\t%s\n", kind, &ackMsg)
+	if err != nil {
+		sc.Logger.Log("Could not %s ack token: %s %v\n", kind, ackToken, err)
+		return err
+	}
+
+	sc.Logger.Log("%s rsp received:\n\t%s\n", kind, ackRsp)
+
+	if ackRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return fmt.Errorf("Error: received while sending %s for ack token: %s err: %s",
+			kind, ackToken, pb.Status_name[int32(ackRsp.RspHeader.Status)])
+	}
+
+	return nil
+}
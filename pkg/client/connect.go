@@ -3,14 +3,22 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/find-in-docs/sidecar/pkg/config"
 	"github.com/find-in-docs/sidecar/pkg/utils"
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
 	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -18,32 +26,65 @@ type SC struct {
 	Client pb.SidecarClient
 	header *pb.Header
 	Logger *Logger
+
+	// headerMu guards header, whose ServId changes if we register again.
+	headerMu sync.RWMutex
+
+	// What we registered with, to register again with if we have to.
+	serviceName string
+	regParams   *pb.RegistrationParams
+	session     *session
+
+	keepAliveMu   sync.Mutex
+	stopKeepAlive context.CancelFunc
+}
+
+// newHeader returns a header for one message. Calls run concurrently, with
+// keepalives going on in the background, so each message gets a copy
+// instead of sharing sc.header.
+func (sc *SC) newHeader(msgType pb.MsgType) *pb.Header {
+
+	sc.headerMu.RLock()
+	header := proto.Clone(sc.header).(*pb.Header)
+	sc.headerMu.RUnlock()
+
+	header.MsgType = msgType
+	header.MsgId = 0
+
+	return header
 }
 
-func connectToSidecar(serviceName string, regParams *pb.RegistrationParams) *SC {
+func connectToSidecar(serviceName string, regParams *pb.RegistrationParams,
+	opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 
 	sidecarServiceAddr := viper.GetString("sidecarServiceAddr")
-  fmt.Printf("sidecarServiceAddr: %s\n", sidecarServiceAddr)
-
-  reTryNum := 0
-  tickerCh := time.Tick(3 * time.Second)
-  for _ := range tickerCh {
-
-    conn, err := Connect(serviceName, sidecarServiceAddr, regParams)
-    if err != nil {
-      fmt.Printf("Error connecting to sidecar. RetryNum: %d, Error: %w", retryNum, err)
-      return nil
-    } else {
-      return conn
-    }
-
-    retryNum++
-  }
+	fmt.Printf("sidecarServiceAddr: %s\n", sidecarServiceAddr)
+
+	retryNum := 0
+	tickerCh := time.Tick(3 * time.Second)
+	for range tickerCh {
+
+		conn, err := Connect(serviceName, sidecarServiceAddr, regParams, opts...)
+		if err == nil {
+			return conn, nil
+		}
+
+		fmt.Printf("Error connecting to sidecar. RetryNum: %d, Error: %v\n", retryNum, err)
+		retryNum++
+	}
+
+	return nil, fmt.Errorf("Error connecting to sidecar at: %s\n", sidecarServiceAddr)
 }
 
 func InitSidecar(serviceName string, regParams *pb.RegistrationParams) (*SC, error) {
 
-  conn := connectToSidecar(serviceName, regParams)
+	// The session is filled in once we register.
+	session := &session{}
+
+	conn, err := connectToSidecar(serviceName, regParams, session.dialOptions()...)
+	if err != nil {
+		return nil, err
+	}
 
 	client := pb.NewSidecarClient(conn)
 	fmt.Printf("GRPC connection to sidecar created\n")
@@ -56,7 +97,18 @@ func InitSidecar(serviceName string, regParams *pb.RegistrationParams) (*SC, err
 	}
 
 	logger := NewLogger(&client, header)
-	sc := &SC{client, header, logger}
+	sc := &SC{
+		Client: client,
+		header: header,
+		Logger: logger,
+
+		session: session,
+	}
+	logger.newHeader = sc.newHeader
+	session.renew = func() error {
+		return sc.Register(sc.serviceName, sc.regParams)
+	}
+
 	err = sc.Register(serviceName, regParams)
 	if err != nil {
 		sc.Logger.Log("Error registering client: %s", err.Error())
@@ -66,13 +118,24 @@ func InitSidecar(serviceName string, regParams *pb.RegistrationParams) (*SC, err
 	return sc, nil
 }
 
-func Connect(serviceName string, serverAddr string, regParams *pb.RegistrationParams) (*grpc.ClientConn, error) {
+// Connect dials the sidecar, with TLS if tls.client in the sidecar-config
+// asks for it. Options in opts come last, so they can override that.
+func Connect(serviceName string, serverAddr string, regParams *pb.RegistrationParams,
+	opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+
+	tlsConfig, err := config.ClientTLS("tls.client")
+	if err != nil {
+		return nil, fmt.Errorf("Error loading TLS config: %w", err)
+	}
 
-	// var opts []grpc.DialOption
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
 
-  fmt.Printf("%s: serverAddr: %s\n", serviceName, serverAddr)
+	fmt.Printf("%s: serverAddr: %s\n", serviceName, serverAddr)
 	conn, err := grpc.Dial(serverAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+		append([]grpc.DialOption{grpc.WithTransportCredentials(creds)}, opts...)...)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating GRPC channel: %w", err)
 	}
@@ -103,6 +166,12 @@ func DefaultRegParams(sc *SC) (*pb.RegistrationParams, error) {
 	}
 	retryDelay := durationpb.New(retryDelayDuration)
 
+	leaseTTLDuration, err := time.ParseDuration("30s")
+	if err != nil {
+		return nil, fmt.Errorf("Error creating Golang time duration: %w\n", err)
+	}
+	leaseTTL := durationpb.New(leaseTTLDuration)
+
 	return &pb.RegistrationParams{
 		CircuitFailureThreshold: circuitConsecutiveFailures,
 		DebounceDelay:           debounceDelay,
@@ -110,7 +179,12 @@ func DefaultRegParams(sc *SC) (*pb.RegistrationParams, error) {
 		Retry: &pb.RetryBehavior{
 			RetryNum:   retryNum,
 			RetryDelay: retryDelay,
+			MaxDelay:   durationpb.New(30 * time.Second),
+			Multiplier: 2,
+			Jitter:     0.2,
 		},
+
+		LeaseTTL: leaseTTL,
 	}, nil
 }
 
@@ -129,9 +203,10 @@ func (sc *SC) Register(serviceName string, regParams *pb.RegistrationParams) err
 		}
 	}
 
-	header := sc.header
-	header.MsgType = pb.MsgType_MSG_TYPE_REG
-	header.MsgId = 0
+	sc.serviceName = serviceName
+	sc.regParams = regParams
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_REG)
 
 	rMsg := &pb.RegistrationMsg{
 		Header: header,
@@ -141,85 +216,226 @@ func (sc *SC) Register(serviceName string, regParams *pb.RegistrationParams) err
 	}
 
 	rRsp, err := sc.Client.Register(context.Background(), rMsg)
-	sc.Logger.Log("Registration msg sent:\n\t%s\n", rMsg)
 	if err != nil {
+		sc.Logger.Log("Registration msg sent:\n\t%s\n", rMsg)
 		sc.Logger.Log("Sending Registration caused error: %v\n", err)
 		return err
 	}
 
+	// The sidecar looks us up by the service ID it assigned to us,
+	// so send it in the header of every message from now on, along
+	// with the session token that proves it is ours.
+	sc.session.set(rRsp.SessionToken, rRsp.SessionExpiresAt.AsTime())
+	sc.headerMu.Lock()
+	sc.header.ServId = rRsp.AssignedServId
+	sc.headerMu.Unlock()
+
+	sc.Logger.Log("Registration msg sent:\n\t%s\n", rMsg)
 	sc.Logger.Log("Registration rsp received:\n\t%s\n", rRsp)
 
-	sc.header.ServId = rRsp.Header.ServId
+	sc.startKeepAlive(rParams.GetLeaseTTL().AsDuration())
 
 	return nil
 }
 
-func (sc *SC) Pub(ctx context.Context, topic string, data []byte, rb *pb.RetryBehavior) error {
+// PubError is returned by Pub and Request when the sidecar answered, but did
+// not send the message. With ERR_CIRCUIT_OPEN, do not send requests to the
+// topic again for RetryAfter.
+type PubError struct {
+	Topic      string
+	Status     pb.Status
+	Msg        string
+	RetryAfter time.Duration
+}
 
-	header := sc.header
-	header.MsgType = pb.MsgType_MSG_TYPE_PUB
-	header.MsgId = 0
+func (e *PubError) Error() string {
 
-	pubMsg := pb.PubMsg{
-		Header: sc.header,
-		Topic:  topic,
-		Msg:    data,
-		Retry:  rb,
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("Error publishing to topic: %s status: %s retry after: %v\n\t%s",
+			e.Topic, e.Status, e.RetryAfter, e.Msg)
+	}
+
+	return fmt.Sprintf("Error publishing to topic: %s status: %s\n\t%s", e.Topic, e.Status, e.Msg)
+}
+
+// RateLimited reports whether the sidecar rejected a call with
+// RESOURCE_EXHAUSTED, and how long to wait before trying again.
+func RateLimited(err error) (time.Duration, bool) {
+
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return 0, false
 	}
 
-	pubRsp, err := sc.Client.Pub(ctx, &pubMsg)
+	for _, detail := range st.Details() {
+		if retryInfo, ok := detail.(*errdetails.RetryInfo); ok {
+			return retryInfo.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	return 0, true
+}
+
+func (sc *SC) Pub(ctx context.Context, topic string, data []byte, rb *pb.RetryBehavior) error {
+
+	_, err := sc.PubWith(ctx, &pb.PubMsg{
+		Topic: topic,
+		Msg:   data,
+		Retry: rb,
+	})
+
+	return err
+}
+
+// PubWith publishes pubMsg, and returns the sidecar's response. Set its
+// IdempotencyKey to have the sidecar drop retries of the same message.
+func (sc *SC) PubWith(ctx context.Context, pubMsg *pb.PubMsg) (*pb.PubMsgResponse, error) {
+
+	pubMsg.Header = sc.newHeader(pb.MsgType_MSG_TYPE_PUB)
+	topic := pubMsg.Topic
+
+	pubRsp, err := sc.Client.Pub(ctx, pubMsg)
 	sc.Logger.Log("Pub message sent: %s\n", pubMsg.String())
 	if err != nil {
 		sc.Logger.Log("Could not publish to topic: %s\n\tmessage:\n\tmsg: %s %v\n",
-			topic, string(data), err)
-		return err
+			topic, string(pubMsg.Msg), err)
+		return nil, err
 	}
 	sc.Logger.Log("Pub rsp received: %s\n", pubRsp)
 
 	if pubRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		err = &PubError{
+			Topic:  topic,
+			Status: pb.Status(pubRsp.RspHeader.Status),
+			Msg:    pubRsp.Msg,
+		}
 		sc.Logger.Log("Error received while publishing to topic:\n\ttopic: %s\n\tmsg: %s %v\n",
+			topic, pubMsg.Msg, err)
+		return nil, err
+	}
+
+	return pubRsp, nil
+}
+
+// Request sends data to the topic and returns the reply. A timeout of 0
+// leaves it to the deadline on ctx.
+func (sc *SC) Request(ctx context.Context, topic string, data []byte,
+	timeout time.Duration, rb *pb.RetryBehavior) ([]byte, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_REQ)
+
+	reqMsg := pb.RequestMsg{
+		Header: header,
+		Topic:  topic,
+		Msg:    data,
+		Retry:  rb,
+	}
+
+	if timeout > 0 {
+		reqMsg.Timeout = durationpb.New(timeout)
+	}
+
+	reqRsp, err := sc.Client.Request(ctx, &reqMsg)
+	sc.Logger.Log("Request message sent: %s\n", reqMsg.String())
+	if err != nil {
+		sc.Logger.Log("Could not send request to topic: %s\n\tmsg: %s %v\n",
+			topic, string(data), err)
+		return nil, err
+	}
+	sc.Logger.Log("Request rsp received: %s\n", reqRsp)
+
+	if reqRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		err = &PubError{
+			Topic:      topic,
+			Status:     pb.Status(reqRsp.RspHeader.Status),
+			Msg:        reqRsp.Msg,
+			RetryAfter: reqRsp.RetryAfter.AsDuration(),
+		}
+		sc.Logger.Log("Error received while sending request to topic:\n\ttopic: %s\n\tmsg: %s %v\n",
 			topic, data, err)
+		return nil, err
+	}
+
+	return reqRsp.Reply, nil
+}
+
+// Respond answers a request received from a subscription.
+// reply is the Reply field of the received SubTopicResponse.
+func (sc *SC) Respond(ctx context.Context, reply string, data []byte) error {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_RESPOND)
+
+	respondMsg := pb.RespondMsg{
+		Header: header,
+		Reply:  reply,
+		Msg:    data,
+	}
+
+	respondRsp, err := sc.Client.Respond(ctx, &respondMsg)
+	sc.Logger.Log("Respond message sent: %s\n", respondMsg.String())
+	if err != nil {
+		sc.Logger.Log("Could not respond to: %s\n\tmsg: %s %v\n",
+			reply, string(data), err)
 		return err
 	}
+	sc.Logger.Log("Respond rsp received: %s\n", respondRsp)
+
+	if respondRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return fmt.Errorf("Error: received while responding to: %s err: %s",
+			reply, pb.Status_name[int32(respondRsp.RspHeader.Status)])
+	}
 
 	return nil
 }
 
-func (sc *SC) Sub(ctx context.Context, topic string, chanSize uint32) error {
+// Sub returns the ID the sidecar gave the subscription. Every call makes
+// a new subscription, even for a topic we already subscribed to.
+func (sc *SC) Sub(ctx context.Context, topic string, chanSize uint32) (uint64, error) {
 
-	header := sc.header
-	header.MsgType = pb.MsgType_MSG_TYPE_SUB
-	header.MsgId = 0
+	return sc.QueueSub(ctx, topic, "", chanSize)
+}
 
-	subMsg := pb.SubMsg{
-		Header:   header,
-		Topic:    topic,
-		ChanSize: chanSize,
-	}
+// QueueSub subscribes as a member of queueGroup. Each message on the topic
+// goes to only one member of the group, across all sidecars.
+func (sc *SC) QueueSub(ctx context.Context, topic, queueGroup string, chanSize uint32) (uint64, error) {
+
+	return sc.SubWith(ctx, &pb.SubMsg{
+		Topic:      topic,
+		ChanSize:   chanSize,
+		QueueGroup: queueGroup,
+	})
+}
+
+// SubWith subscribes with all the options in subMsg, such as what to do
+// when we do not keep up with the messages. The header is filled in for us.
+func (sc *SC) SubWith(ctx context.Context, subMsg *pb.SubMsg) (uint64, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_SUB)
 
-	subRsp, err := sc.Client.Sub(ctx, &subMsg)
-	sc.Logger.Log("Sub message sent:\n\t%s\n", &subMsg)
+	subMsg.Header = header
+	topic := subMsg.Topic
+
+	subRsp, err := sc.Client.Sub(ctx, subMsg)
+	sc.Logger.Log("Sub message sent:\n\t%s\n", subMsg)
 	if err != nil {
 		sc.Logger.Log("Could not subscribe to topic: %s %v\n",
 			topic, err)
-		return err
+		return 0, err
 	}
 	sc.Logger.Log("Sub rsp received:\n\t%s\n", subRsp)
 
 	if subRsp.RspHeader.Status != uint32(pb.Status_OK) {
 		sc.Logger.Log("Error received while publishing to topic:\n\ttopic: %s %v\n",
 			topic, err)
-		return err
+		return 0, err
 	}
 
-	return nil
+	return subRsp.SubId, nil
 }
 
 func (sc *SC) Unsub(ctx context.Context, topic string) error {
 
-	header := sc.header
-	header.MsgType = pb.MsgType_MSG_TYPE_UNSUB
-	header.MsgId = 0
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_UNSUB)
 
 	unsubMsg := pb.UnsubMsg{
 		Header: header,
@@ -246,40 +462,37 @@ type Response struct {
 	err      error
 }
 
+func (r *Response) Msg() *pb.SubTopicResponse {
+
+	return r.response
+}
+
+// Err is set on the last response if the stream broke.
+func (r *Response) Err() error {
+
+	return r.err
+}
+
 func (sc *SC) ProcessSubMsgs(ctx context.Context, topic string,
 	chanSize uint32, f func(*pb.SubTopicResponse)) error {
 
-	responseCh := sc.Recv(ctx, topic)
+	responseCh, err := sc.Subscribe(ctx, topic, chanSize)
+	if err != nil {
+		return err
+	}
 
 	goroutineName := "ProcessSubMsgs"
-	var err error
 	err = utils.StartGoroutine(goroutineName,
 		func() {
-			subscribedTopic := topic
-
-		LOOP:
-			for {
-				select {
-
-				case r := <-responseCh:
-					if r.err != nil {
-						sc.Logger.Log("Error receiving from sidecar: %v\n", r.err)
-						_ = sc.Unsub(ctx, subscribedTopic)
-						break LOOP
-					}
-
-					// Do not log received message to NATS. This creates a loop.
-
-					f(r.response)
+			for r := range responseCh {
+				if r.err != nil {
+					sc.Logger.Log("Error receiving from sidecar: %v\n", r.err)
+					break
+				}
 
-				case <-ctx.Done():
-					_ = sc.Unsub(ctx, subscribedTopic)
+				// Do not log received message to NATS. This creates a loop.
 
-					if ctx.Err() != nil {
-						sc.Logger.Log("Done channel signaled: %v\n", err)
-					}
-					break LOOP
-				}
+				f(r.response)
 			}
 			fmt.Printf("GOROUTINE 2 completed in function ProcessSubMsgs\n")
 			utils.GoroutineEnded(goroutineName)
@@ -290,49 +503,74 @@ func (sc *SC) ProcessSubMsgs(ctx context.Context, topic string,
 		os.Exit(-1)
 	}
 
-	err = sc.Sub(ctx, topic, chanSize)
-	if err != nil {
-		return err
-	}
-
 	return nil
 }
 
-func (sc *SC) Recv(ctx context.Context, topic string) <-chan *Response {
+// Subscribe streams the messages on topic from the sidecar. The stream has
+// a subscription of its own, which the sidecar removes when the stream ends.
+// The channel is closed once ctx is cancelled or the topic is unsubscribed.
+func (sc *SC) Subscribe(ctx context.Context, topic string, chanSize uint32) (<-chan *Response, error) {
 
-	header := sc.header
-	header.MsgId = 0
+	return sc.QueueSubscribe(ctx, topic, "", chanSize)
+}
 
-	recvMsg := pb.Receive{
-		Header: header,
-		Topic:  topic,
+// QueueSubscribe is Subscribe as a member of queueGroup.
+func (sc *SC) QueueSubscribe(ctx context.Context, topic, queueGroup string,
+	chanSize uint32) (<-chan *Response, error) {
+
+	return sc.SubscribeWith(ctx, &pb.SubMsg{
+		Topic:      topic,
+		ChanSize:   chanSize,
+		QueueGroup: queueGroup,
+	})
+}
+
+// SubscribeWith is Subscribe with all the options in subMsg. Each response
+// carries the number of messages dropped so far, if the overflow policy
+// drops messages when we do not keep up.
+func (sc *SC) SubscribeWith(ctx context.Context, subMsg *pb.SubMsg) (<-chan *Response, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_SUB)
+
+	subMsg.Header = header
+	topic := subMsg.Topic
+
+	stream, err := sc.Client.Subscribe(sc.streamContext(ctx), subMsg)
+	sc.Logger.Log("Subscribe message sent:\n\t%s\n", subMsg)
+	if err != nil {
+		sc.Logger.Log("Could not subscribe to topic: %s %v\n", topic, err)
+		return nil, err
 	}
 
 	responseCh := make(chan *Response)
 
-	goroutineName := "Recv"
-	err := utils.StartGoroutine(goroutineName,
+	goroutineName := "Subscribe"
+	err = utils.StartGoroutine(goroutineName,
 		func() {
 		LOOP:
 			for {
-				subTopicRsp, err := sc.Client.Recv(ctx, &recvMsg)
+				subTopicRsp, err := stream.Recv()
+				if err == io.EOF {
+					break LOOP
+				}
 				if err != nil {
-					sc.Logger.Log("Could not receive from sidecar - err: %v\n", err)
+					// Cancelling ctx is how the caller ends the stream.
+					if ctx.Err() == nil {
+						responseCh <- &Response{nil, err}
+					}
 					break LOOP
 				}
 
 				// Do not log received message to NATS. This creates a loop.
 
-				responseCh <- &Response{
-					subTopicRsp,
-					nil,
-				}
-
-				if ctx.Err() != nil {
+				select {
+				case responseCh <- &Response{subTopicRsp, nil}:
+				case <-ctx.Done():
 					break LOOP
 				}
 			}
-			fmt.Printf("GOROUTINE 1 completed in function Recv\n")
+			close(responseCh)
+			fmt.Printf("GOROUTINE 1 completed in function Subscribe\n")
 			utils.GoroutineEnded(goroutineName)
 		})
 
@@ -341,5 +579,5 @@ func (sc *SC) Recv(ctx context.Context, topic string) <-chan *Response {
 		os.Exit(-1)
 	}
 
-	return responseCh
+	return responseCh, nil
 }
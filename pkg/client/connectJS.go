@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/find-in-docs/sidecar/pkg/utils"
@@ -12,24 +13,30 @@ import (
 	"github.com/spf13/viper"
 )
 
-func (sc *SC) ReceiveDocs(ctx context.Context, subject, durableName string) (chan *pb.DocDownload, error) {
+// ReceiveDocs delivers the documents on subject, fetched from the durable
+// consumer on stream. Empty arguments take their value from the
+// sidecar-config. Pass the AckToken of each DocDownload to Ack once its
+// documents are processed. Anything that is not acknowledged is delivered
+// again. Each call opens a stream of its own, and calls bound to the same
+// consumer share its documents.
+func (sc *SC) ReceiveDocs(ctx context.Context, stream, subject, durableName string) (chan *pb.DocDownload, error) {
 
-	err := sc.AddJS(ctx, subject, durableName)
-	if err != nil {
-		return nil, fmt.Errorf("Error adding jetstream: %w", err)
-	}
+	recvChanSize := viper.GetInt("nats.jetstream.recvChanSize")
+	recvDocs := make(chan *pb.DocDownload, recvChanSize)
 
-	flowControlTimeoutInNs, err := time.ParseDuration(viper.GetString("nats.jetstream.flowControlTimeoutInNs"))
+	docStream, err := sc.Client.DocDownloadStream(sc.streamContext(ctx))
 	if err != nil {
-		return nil, fmt.Errorf("Error parsing flow control timeout: %w", err)
+		return nil, fmt.Errorf("Error initializing document download stream: %w", err)
 	}
 
-	recvChanSize := viper.GetInt("nats.jetstream.recvChanSize")
-	recvDocs := make(chan *pb.DocDownload, recvChanSize)
+	if err = docStream.Send(&pb.DocDownloadResponse{
+		Control: &pb.StreamControl{
+			Flow: pb.StreamFlow_ON,
+		},
 
-	stream, err := sc.Client.DocDownloadStream(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("Error initializing document upload stream: %w", err)
+		Open: sc.docStreamOpen(stream, subject, durableName),
+	}); err != nil {
+		return nil, fmt.Errorf("Error opening document download stream: %w", err)
 	}
 
 	utils.StartGoroutine("downloadDocsClientRecv", func() {
@@ -42,7 +49,7 @@ func (sc *SC) ReceiveDocs(ctx context.Context, subject, durableName string) (cha
 				}
 				break LOOP
 			default:
-				docsDownload, err := stream.Recv()
+				docsDownload, err := docStream.Recv()
 				if err == io.EOF {
 					fmt.Printf("Document download stream ended.")
 					break LOOP
@@ -58,94 +65,34 @@ func (sc *SC) ReceiveDocs(ctx context.Context, subject, durableName string) (cha
 		}
 	})
 
-	utils.StartGoroutine("downloadDocsClientSend", func() {
-	LOOP2:
-		for {
-			select {
-			case <-ctx.Done():
-				if ctx.Err() != nil {
-					sc.Logger.Log("Done channel signaled: %v\n", err)
-				}
-				break LOOP2
-			case <-time.After(flowControlTimeoutInNs):
-
-				percentChannelUsed := int((cap(recvDocs) - len(recvDocs)) / (cap(recvDocs) * 100))
-				// fmt.Printf("cap: %d, l: %d, percentChannelUsed: %d: ",
-				//	cap(recvDocs), len(recvDocs), percentChannelUsed)
-
-				if percentChannelUsed > 50 {
-
-					fmt.Printf("v")
-					if err = stream.Send(&pb.DocDownloadResponse{
-						Control: &pb.StreamControl{
-							Flow: pb.StreamFlow_OFF,
-						},
-
-						AckMsgNumber: 0,
-					}); err != nil {
-
-						break LOOP2
-					}
-				} else {
-					fmt.Printf("^")
-					if err = stream.Send(&pb.DocDownloadResponse{
-						Control: &pb.StreamControl{
-							Flow: pb.StreamFlow_ON,
-						},
-
-						AckMsgNumber: 0,
-					}); err != nil {
-
-						break LOOP2
-					}
-				}
-			}
-		}
+	sc.downloadFlowControl(ctx, docStream, func() int {
+		return percentUsed(len(recvDocs), cap(recvDocs))
 	})
 
 	return recvDocs, nil
 }
 
-func (sc *SC) UploadDocs(wg sync.WaitGroup, docsCh <-chan *pb.Doc) error {
+// UploadDocs publishes the documents from docsCh on subject, which must
+// belong to stream. The upload slows down while the durable consumer falls
+// behind. Empty arguments take their value from the sidecar-config. Once
+// docsCh is closed, it returns an error unless every document was stored.
+func (sc *SC) UploadDocs(wg *sync.WaitGroup, docsCh <-chan *pb.Doc, stream, subject, durableName string) error {
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	var flow pb.StreamFlow
-	stream, err := sc.Client.DocUploadStream(ctx)
+	docStream, err := sc.Client.DocUploadStream(sc.streamContext(ctx))
 	if err != nil {
 		return fmt.Errorf("Error initializing document upload stream: %w", err)
 	}
 
-	utils.StartGoroutine("uploadDocsClientRecv", func() {
-	LOOP:
-		for {
-			select {
-			case <-ctx.Done():
-				if ctx.Err() != nil {
-					sc.Logger.Log("Done channel signaled: %v\n", err)
-				}
-				break LOOP
-			default:
-				response, err := stream.Recv()
-				if err == io.EOF {
-					fmt.Printf("Document upload stream ended.")
-					break LOOP
-				}
-				if err != nil {
-					fmt.Printf("Error receiving from document upload stream: %w", err)
-					break LOOP
-				}
+	if err = docStream.Send(&pb.DocUpload{
+		Open: sc.docStreamOpen(stream, subject, durableName),
+	}); err != nil {
+		return fmt.Errorf("Error opening document upload stream: %w", err)
+	}
 
-				flow = response.Control.Flow
-				if flow == pb.StreamFlow_ON {
-					fmt.Printf("^")
-				} else {
-					fmt.Printf("v")
-				}
-			}
-		}
-	})
+	u := sc.uploadFlowControl(ctx, docStream)
 
 	documents := new(pb.Documents)
 	chunkSize := viper.GetInt("nats.jetstream.msgChunkSize")
@@ -174,7 +121,7 @@ LOOP2:
 				break LOOP2
 			}
 
-			for flow == pb.StreamFlow_OFF {
+			for !u.flowing() && ctx.Err() == nil {
 				time.Sleep(flowControlTimeoutInNs)
 			}
 
@@ -182,7 +129,7 @@ LOOP2:
 			docs[count] = doc
 			if count == chunkSize-1 {
 
-				if err = stream.Send(&pb.DocUpload{
+				if err = docStream.Send(&pb.DocUpload{
 					Documents: documents,
 					MsgNumber: msgNumber,
 				}); err != nil {
@@ -205,18 +152,183 @@ LOOP2:
 		}
 	}
 
+	// The sidecar ends the stream once it published everything sent.
+	// Cancelling before then would lose what is still on its way.
+	if err = docStream.CloseSend(); err == nil {
+		<-u.done
+		err = u.err()
+	}
+
 	wg.Done()
-	return nil
+	return err
+}
+
+type downloadStream interface {
+	Send(*pb.DocDownloadResponse) error
+}
+
+// downloadFlowControl asks the sidecar to stop sending while the receiving
+// channel is more than half full, and to start again once it drains.
+func (sc *SC) downloadFlowControl(ctx context.Context, stream downloadStream, used func() int) {
+
+	flowControlTimeoutInNs, err := time.ParseDuration(viper.GetString("nats.jetstream.flowControlTimeoutInNs"))
+	if err != nil {
+		sc.Logger.Log("Error parsing flow control timeout: %v\n", err)
+		return
+	}
+
+	utils.StartGoroutine("downloadDocsClientSend", func() {
+	LOOP2:
+		for {
+			select {
+			case <-ctx.Done():
+				if ctx.Err() != nil {
+					sc.Logger.Log("Done channel signaled: %v\n", ctx.Err())
+				}
+				break LOOP2
+			case <-time.After(flowControlTimeoutInNs):
+
+				flow := pb.StreamFlow_ON
+				if used() > 50 {
+					flow = pb.StreamFlow_OFF
+					fmt.Printf("v")
+				} else {
+					fmt.Printf("^")
+				}
+
+				if err := stream.Send(&pb.DocDownloadResponse{
+					Control: &pb.StreamControl{
+						Flow: flow,
+					},
+
+					AckMsgNumber: 0,
+				}); err != nil {
+
+					break LOOP2
+				}
+			}
+		}
+	})
+}
+
+func percentUsed(l, c int) int {
+
+	if c == 0 {
+		return 0
+	}
+
+	return 100 * l / c
+}
+
+type uploadStream interface {
+	Recv() (*pb.DocUploadResponse, error)
+}
+
+// uploadState follows what the sidecar says on an upload stream:
+// when to send, and what became of each message sent.
+type uploadState struct {
+	flow atomic.Int32
+
+	// Closed once the sidecar ends the stream.
+	done chan struct{}
+
+	mu        sync.Mutex
+	numStored uint64
+	failures  []string
+	streamErr error
+}
+
+// flowing reports whether to send. Sending starts out
+// stopped, until the sidecar says otherwise.
+func (u *uploadState) flowing() bool {
+
+	return pb.StreamFlow(u.flow.Load()) != pb.StreamFlow_OFF
+}
+
+// err is nil if the sidecar ended the stream, and JetStream stored every
+// message. Call it once done is closed.
+func (u *uploadState) err() error {
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if len(u.failures) > 0 {
+		return fmt.Errorf("Error: %d uploaded messages may not have been stored. First failure: %s",
+			len(u.failures), u.failures[0])
+	}
+
+	return u.streamErr
+}
+
+func (sc *SC) uploadFlowControl(ctx context.Context, stream uploadStream) *uploadState {
+
+	u := &uploadState{done: make(chan struct{})}
+
+	utils.StartGoroutine("uploadDocsClientRecv", func() {
+		defer close(u.done)
+	LOOP:
+		for {
+			select {
+			case <-ctx.Done():
+				if ctx.Err() != nil {
+					sc.Logger.Log("Done channel signaled: %v\n", ctx.Err())
+				}
+				break LOOP
+			default:
+				response, err := stream.Recv()
+				if err == io.EOF {
+					fmt.Printf("Document upload stream ended.")
+					break LOOP
+				}
+				if err != nil {
+					fmt.Printf("Error receiving from document upload stream: %v\n", err)
+					u.mu.Lock()
+					u.streamErr = err
+					u.mu.Unlock()
+					break LOOP
+				}
+
+				if response.Control != nil {
+					f := response.Control.GetFlow()
+					u.flow.Store(int32(f))
+					if f == pb.StreamFlow_ON {
+						fmt.Printf("^")
+					} else {
+						fmt.Printf("v")
+					}
+				}
+
+				u.mu.Lock()
+				if response.PubAck != nil {
+					u.numStored++
+				} else if response.Error != "" {
+					u.failures = append(u.failures, fmt.Sprintf("msgNumber: %d err: %s",
+						response.AckMsgNumber, response.Error))
+				}
+				u.mu.Unlock()
+			}
+		}
+	})
+
+	return u
+}
+
+func (sc *SC) docStreamOpen(stream, subject, durableName string) *pb.DocStreamOpen {
+
+	return &pb.DocStreamOpen{
+		Header:      sc.newHeader(pb.MsgType_MSG_TYPE_ADD_JS),
+		Subject:     subject,
+		Stream:      stream,
+		DurableName: durableName,
+	}
 }
 
 func (sc *SC) AddJS(ctx context.Context, topic, workQueue string) error {
 
-	header := sc.header
-	header.MsgType = pb.MsgType_MSG_TYPE_ADD_JS
-	header.MsgId = 0
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_ADD_JS)
 
 	addJSMsg := pb.AddJSMsg{
-		Header:    sc.header,
+		Header:    header,
 		Topic:     topic,
 		WorkQueue: workQueue,
 	}
@@ -242,9 +354,7 @@ func (sc *SC) AddJS(ctx context.Context, topic, workQueue string) error {
 
 func (sc *SC) UnsubJS(ctx context.Context, topic string, workQueue string) error {
 
-	header := sc.header
-	header.MsgType = pb.MsgType_MSG_TYPE_UNSUB_JS
-	header.MsgId = 0
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_UNSUB_JS)
 
 	unsubJSMsg := pb.UnsubJSMsg{
 		Header:    header,
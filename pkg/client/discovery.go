@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+)
+
+// Discover returns the live instances of a service across all sidecars.
+// An empty serviceName returns every registered service.
+func (sc *SC) Discover(ctx context.Context, serviceName string) ([]*pb.ServiceInstance, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_DISCOVER)
+
+	discoverMsg := pb.DiscoverMsg{
+		Header:      header,
+		ServiceName: serviceName,
+	}
+
+	discoverRsp, err := sc.Client.Discover(ctx, &discoverMsg)
+	sc.Logger.Log("Discover message sent:\n\t%s\n", &discoverMsg)
+	if err != nil {
+		sc.Logger.Log("Could not discover service: %s %v\n", serviceName, err)
+		return nil, err
+	}
+
+	sc.Logger.Log("Discover rsp received:\n\t%s\n", discoverRsp)
+
+	if discoverRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return nil, fmt.Errorf("Error: received while discovering service: %s err: %s",
+			serviceName, pb.Status_name[int32(discoverRsp.RspHeader.Status)])
+	}
+
+	return discoverRsp.Instances, nil
+}
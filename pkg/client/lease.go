@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/find-in-docs/sidecar/pkg/utils"
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Send keepalives a few times per lease, so that one lost
+	// keepalive does not expire the lease. Each one also gets us
+	// a fresh session token.
+	keepAlivesPerLease = 3
+
+	servIdMetadataKey = "servid"
+)
+
+func (sc *SC) startKeepAlive(leaseTTL time.Duration) {
+
+	// Registering again restarts keepalives from any goroutine,
+	// even the keepalive goroutine itself.
+	sc.keepAliveMu.Lock()
+	defer sc.keepAliveMu.Unlock()
+
+	if sc.stopKeepAlive != nil {
+		sc.stopKeepAlive()
+		sc.stopKeepAlive = nil
+	}
+
+	// Without a lease, keepalives still have to renew the session.
+	period := leaseTTL
+	if sessionTTL := sc.session.ttl(); sessionTTL > 0 && (period <= 0 || sessionTTL < period) {
+		period = sessionTTL
+	}
+
+	if period <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sc.stopKeepAlive = cancel
+
+	goroutineName := "KeepAlive"
+	err := utils.StartGoroutine(goroutineName,
+		func() {
+			ticker := time.NewTicker(period / keepAlivesPerLease)
+			defer ticker.Stop()
+
+		LOOP:
+			for {
+				select {
+				case <-ticker.C:
+					if err := sc.KeepAlive(ctx); err != nil {
+						sc.Logger.Log("Error sending keepalive: %v\n", err)
+					}
+
+				case <-ctx.Done():
+					break LOOP
+				}
+			}
+
+			fmt.Printf("GOROUTINE completed in function startKeepAlive\n")
+			utils.GoroutineEnded(goroutineName)
+		})
+
+	if err != nil {
+		fmt.Printf("Error starting goroutine: %v\n", err)
+	}
+}
+
+func (sc *SC) KeepAlive(ctx context.Context) error {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_KEEPALIVE)
+
+	keepAliveMsg := pb.KeepAliveMsg{
+		Header: header,
+	}
+
+	token := sc.session.get()
+
+	// Do not log keepalives to NATS. There are too many of them.
+	keepAliveRsp, err := sc.Client.KeepAlive(ctx, &keepAliveMsg)
+	if err != nil {
+		return err
+	}
+
+	// Our lease ran out, though our session did not.
+	if keepAliveRsp.RspHeader.Status == uint32(pb.Status_ERR_NOT_REGISTERED) {
+		err = fmt.Errorf("Error: keepalive rejected: %s err: %s",
+			keepAliveRsp.Msg, pb.Status_name[int32(keepAliveRsp.RspHeader.Status)])
+		sc.session.registerAgain(token, err)
+		return err
+	}
+
+	if keepAliveRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return fmt.Errorf("Error: keepalive rejected: %s err: %s",
+			keepAliveRsp.Msg, pb.Status_name[int32(keepAliveRsp.RspHeader.Status)])
+	}
+
+	sc.session.set(keepAliveRsp.SessionToken, keepAliveRsp.SessionExpiresAt.AsTime())
+
+	return nil
+}
+
+func (sc *SC) Deregister(ctx context.Context) error {
+
+	sc.keepAliveMu.Lock()
+	if sc.stopKeepAlive != nil {
+		sc.stopKeepAlive()
+		sc.stopKeepAlive = nil
+	}
+	sc.keepAliveMu.Unlock()
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_DEREG)
+
+	deregMsg := pb.DeregistrationMsg{
+		Header: header,
+	}
+
+	deregRsp, err := sc.Client.Deregister(ctx, &deregMsg)
+	if err != nil {
+		sc.Logger.Log("Deregistration msg sent:\n\t%s\n", &deregMsg)
+		sc.Logger.Log("Sending Deregistration caused error: %v\n", err)
+		return err
+	}
+
+	// Our service ID and session are gone. From now on, only log locally.
+	sc.session.set("", time.Time{})
+	sc.headerMu.Lock()
+	sc.header.ServId = nil
+	sc.headerMu.Unlock()
+
+	sc.Logger.Log("Deregistration msg sent:\n\t%s\n", &deregMsg)
+
+	sc.Logger.Log("Deregistration rsp received:\n\t%s\n", deregRsp)
+
+	if deregRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return fmt.Errorf("Error: received while deregistering: %s err: %s",
+			deregRsp.Msg, pb.Status_name[int32(deregRsp.RspHeader.Status)])
+	}
+
+	return nil
+}
+
+// streamContext attaches our service ID to a stream, so that the sidecar
+// can close the stream when our registration goes away.
+func (sc *SC) streamContext(ctx context.Context) context.Context {
+
+	sc.headerMu.RLock()
+	servId := string(sc.header.ServId)
+	sc.headerMu.RUnlock()
+
+	return metadata.AppendToOutgoingContext(ctx, servIdMetadataKey, servId)
+}
@@ -5,12 +5,17 @@ import (
 	"fmt"
 
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/protobuf/proto"
 )
 
 type Logger struct {
 	client *pb.SidecarClient
 	topic  string
 	header *pb.Header
+
+	// newHeader copies the header of the SC the logger belongs to,
+	// which guards it against changes.
+	newHeader func(pb.MsgType) *pb.Header
 }
 
 func NewLogger(client *pb.SidecarClient, header *pb.Header) *Logger {
@@ -33,9 +38,19 @@ func (l *Logger) logString(msg *string) {
 	// Print message to stdout
 	fmt.Println(*msg)
 
-	header := l.header
-	header.MsgType = pb.MsgType_MSG_TYPE_LOG
-	header.MsgId = 0
+	var header *pb.Header
+	if l.newHeader != nil {
+		header = l.newHeader(pb.MsgType_MSG_TYPE_LOG)
+	} else {
+		header = proto.Clone(l.header).(*pb.Header)
+		header.MsgType = pb.MsgType_MSG_TYPE_LOG
+		header.MsgId = 0
+	}
+
+	// The sidecar only takes logs from clients that registered.
+	if len(header.ServId) == 0 {
+		return
+	}
 
 	logMsg := pb.LogMsg{
 		Header: header,
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/grpc"
+)
+
+// AddStream creates a JetStream stream. Zero limits mean unlimited.
+func (sc *SC) AddStream(ctx context.Context, cfg *pb.StreamConfig) (*pb.StreamInfo, error) {
+
+	return sc.streamConfigRequest(ctx, "AddStream", sc.Client.AddStream, cfg)
+}
+
+// UpdateStream changes the configuration of an existing stream.
+// JetStream does not allow every field to change, storage for one.
+func (sc *SC) UpdateStream(ctx context.Context, cfg *pb.StreamConfig) (*pb.StreamInfo, error) {
+
+	return sc.streamConfigRequest(ctx, "UpdateStream", sc.Client.UpdateStream, cfg)
+}
+
+// DeleteStream deletes a stream, along with its messages and consumers.
+func (sc *SC) DeleteStream(ctx context.Context, name string) error {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_STREAM)
+
+	streamMsg := pb.StreamNameMsg{
+		Header: header,
+		Name:   name,
+	}
+
+	streamRsp, err := sc.Client.DeleteStream(ctx, &streamMsg)
+	_, err = sc.checkStreamRsp("DeleteStream", name, &streamMsg, streamRsp, err)
+
+	return err
+}
+
+// StreamInfo returns the configuration and state of a stream.
+func (sc *SC) StreamInfo(ctx context.Context, name string) (*pb.StreamInfo, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_STREAM)
+
+	streamMsg := pb.StreamNameMsg{
+		Header: header,
+		Name:   name,
+	}
+
+	streamRsp, err := sc.Client.GetStreamInfo(ctx, &streamMsg)
+
+	return sc.checkStreamRsp("GetStreamInfo", name, &streamMsg, streamRsp, err)
+}
+
+func (sc *SC) streamConfigRequest(ctx context.Context, kind string,
+	f func(context.Context, *pb.StreamMsg, ...grpc.CallOption) (*pb.StreamMsgResponse, error),
+	cfg *pb.StreamConfig) (*pb.StreamInfo, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_STREAM)
+
+	streamMsg := pb.StreamMsg{
+		Header: header,
+		Config: cfg,
+	}
+
+	streamRsp, err := f(ctx, &streamMsg)
+
+	return sc.checkStreamRsp(kind, cfg.GetName(), &streamMsg, streamRsp, err)
+}
+
+func (sc *SC) checkStreamRsp(kind, name string, streamMsg fmt.Stringer,
+	streamRsp *pb.StreamMsgResponse, err error) (*pb.StreamInfo, error) {
+
+	sc.Logger.Log("%s message sent:\n\t%s\n", kind, streamMsg)
+	if err != nil {
+		sc.Logger.Log("Could not %s for stream: %s %v\n", kind, name, err)
+		return nil, err
+	}
+
+	sc.Logger.Log("%s rsp received:\n\t%s\n", kind, streamRsp)
+
+	if streamRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return nil, fmt.Errorf("Error: received while sending %s for stream: %s err: %s",
+			kind, name, pb.Status_name[int32(streamRsp.RspHeader.Status)])
+	}
+
+	return streamRsp.Info, nil
+}
+
+// AddConsumer creates a durable consumer on stream.
+func (sc *SC) AddConsumer(ctx context.Context, stream string, cfg *pb.ConsumerConfig) (*pb.ConsumerInfo, error) {
+
+	return sc.consumerConfigRequest(ctx, "AddConsumer", sc.Client.AddConsumer, stream, cfg)
+}
+
+// UpdateConsumer changes the configuration of an existing consumer.
+func (sc *SC) UpdateConsumer(ctx context.Context, stream string, cfg *pb.ConsumerConfig) (*pb.ConsumerInfo, error) {
+
+	return sc.consumerConfigRequest(ctx, "UpdateConsumer", sc.Client.UpdateConsumer, stream, cfg)
+}
+
+// DeleteConsumer deletes a durable consumer from stream.
+func (sc *SC) DeleteConsumer(ctx context.Context, stream, durableName string) error {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_CONSUMER)
+
+	consumerMsg := pb.ConsumerNameMsg{
+		Header:      header,
+		Stream:      stream,
+		DurableName: durableName,
+	}
+
+	consumerRsp, err := sc.Client.DeleteConsumer(ctx, &consumerMsg)
+	_, err = sc.checkConsumerRsp("DeleteConsumer", durableName, &consumerMsg, consumerRsp, err)
+
+	return err
+}
+
+// ConsumerInfo returns the configuration and state of a consumer.
+func (sc *SC) ConsumerInfo(ctx context.Context, stream, durableName string) (*pb.ConsumerInfo, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_CONSUMER)
+
+	consumerMsg := pb.ConsumerNameMsg{
+		Header:      header,
+		Stream:      stream,
+		DurableName: durableName,
+	}
+
+	consumerRsp, err := sc.Client.GetConsumerInfo(ctx, &consumerMsg)
+
+	return sc.checkConsumerRsp("GetConsumerInfo", durableName, &consumerMsg, consumerRsp, err)
+}
+
+func (sc *SC) consumerConfigRequest(ctx context.Context, kind string,
+	f func(context.Context, *pb.ConsumerMsg, ...grpc.CallOption) (*pb.ConsumerMsgResponse, error),
+	stream string, cfg *pb.ConsumerConfig) (*pb.ConsumerInfo, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_CONSUMER)
+
+	consumerMsg := pb.ConsumerMsg{
+		Header: header,
+		Stream: stream,
+		Config: cfg,
+	}
+
+	consumerRsp, err := f(ctx, &consumerMsg)
+
+	return sc.checkConsumerRsp(kind, cfg.GetDurableName(), &consumerMsg, consumerRsp, err)
+}
+
+func (sc *SC) checkConsumerRsp(kind, durableName string, consumerMsg fmt.Stringer,
+	consumerRsp *pb.ConsumerMsgResponse, err error) (*pb.ConsumerInfo, error) {
+
+	sc.Logger.Log("%s message sent:\n\t%s\n", kind, consumerMsg)
+	if err != nil {
+		sc.Logger.Log("Could not %s for consumer: %s %v\n", kind, durableName, err)
+		return nil, err
+	}
+
+	sc.Logger.Log("%s rsp received:\n\t%s\n", kind, consumerRsp)
+
+	if consumerRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return nil, fmt.Errorf("Error: received while sending %s for consumer: %s err: %s",
+			kind, durableName, pb.Status_name[int32(consumerRsp.RspHeader.Status)])
+	}
+
+	return consumerRsp.Info, nil
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/find-in-docs/sidecar/pkg/utils"
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/spf13/viper"
+)
+
+// ReceivePayloads is ReceiveDocs for any payload.
+func (sc *SC) ReceivePayloads(ctx context.Context, stream, subject, durableName string) (chan *pb.PayloadDownload, error) {
+
+	recvChanSize := viper.GetInt("nats.jetstream.recvChanSize")
+	recvPayloads := make(chan *pb.PayloadDownload, recvChanSize)
+
+	payloadStream, err := sc.Client.PayloadDownloadStream(sc.streamContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error initializing payload download stream: %w", err)
+	}
+
+	if err = payloadStream.Send(&pb.DocDownloadResponse{
+		Control: &pb.StreamControl{
+			Flow: pb.StreamFlow_ON,
+		},
+
+		Open: sc.docStreamOpen(stream, subject, durableName),
+	}); err != nil {
+		return nil, fmt.Errorf("Error opening payload download stream: %w", err)
+	}
+
+	utils.StartGoroutine("downloadPayloadsClientRecv", func() {
+	LOOP:
+		for {
+			payloadDownload, err := payloadStream.Recv()
+			if err == io.EOF {
+				fmt.Printf("Payload download stream ended.")
+				break LOOP
+			}
+			if err != nil {
+				sc.Logger.Log("Error receiving from payload download stream: %v\n", err)
+				break LOOP
+			}
+
+			select {
+			case recvPayloads <- payloadDownload:
+			case <-ctx.Done():
+				break LOOP
+			}
+		}
+	})
+
+	sc.downloadFlowControl(ctx, payloadStream, func() int {
+		return percentUsed(len(recvPayloads), cap(recvPayloads))
+	})
+
+	return recvPayloads, nil
+}
+
+// UploadPayloads publishes the payloads from payloadsCh on subject, which
+// must belong to stream. The upload slows down while the durable consumer
+// falls behind. Empty arguments take their value from the sidecar-config.
+// It returns once payloadsCh is closed and JetStream stored every payload,
+// or failed to, or once ctx is done.
+func (sc *SC) UploadPayloads(ctx context.Context, payloadsCh <-chan *pb.Payload,
+	stream, subject, durableName string) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	flowControlTimeoutInNs, err := time.ParseDuration(viper.GetString("nats.jetstream.flowControlTimeoutInNs"))
+	if err != nil {
+		return fmt.Errorf("Error parsing flow control timeout: %w", err)
+	}
+
+	payloadStream, err := sc.Client.PayloadUploadStream(sc.streamContext(ctx))
+	if err != nil {
+		return fmt.Errorf("Error initializing payload upload stream: %w", err)
+	}
+
+	if err = payloadStream.Send(&pb.PayloadUpload{
+		Open: sc.docStreamOpen(stream, subject, durableName),
+	}); err != nil {
+		return fmt.Errorf("Error opening payload upload stream: %w", err)
+	}
+
+	u := sc.uploadFlowControl(ctx, payloadStream)
+
+	var msgNumber uint64
+
+LOOP:
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case payload, ok := <-payloadsCh:
+			if !ok {
+				break LOOP
+			}
+
+			for !u.flowing() && ctx.Err() == nil {
+				time.Sleep(flowControlTimeoutInNs)
+			}
+
+			if err = payloadStream.Send(&pb.PayloadUpload{
+				Payload:   payload,
+				MsgNumber: msgNumber,
+			}); err != nil {
+				return fmt.Errorf("Error sending to payload upload stream: %w", err)
+			}
+			msgNumber++
+		}
+	}
+
+	// The sidecar ends the stream once it published everything sent.
+	if err = payloadStream.CloseSend(); err != nil {
+		return fmt.Errorf("Error closing payload upload stream: %w", err)
+	}
+	<-u.done
+
+	return u.err()
+}
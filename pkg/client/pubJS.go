@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+)
+
+// PubJS publishes data to JetStream, and returns once JetStream stored it.
+func (sc *SC) PubJS(ctx context.Context, topic string, data []byte) (*pb.PubAck, error) {
+
+	pubJSRsp, err := sc.pubJS(ctx, topic, data, pb.PubAckMode_PUB_ACK_SYNC)
+	if err != nil {
+		return nil, err
+	}
+
+	return pubJSRsp.PubAck, nil
+}
+
+// PubJSAsync publishes data to JetStream without waiting for it to be
+// stored. The result comes later on PubAcks, with the pubId returned.
+func (sc *SC) PubJSAsync(ctx context.Context, topic string, data []byte) (uint64, error) {
+
+	pubJSRsp, err := sc.pubJS(ctx, topic, data, pb.PubAckMode_PUB_ACK_ASYNC)
+	if err != nil {
+		return 0, err
+	}
+
+	return pubJSRsp.PubId, nil
+}
+
+func (sc *SC) pubJS(ctx context.Context, topic string, data []byte,
+	ackMode pb.PubAckMode) (*pb.PubJSMsgResponse, error) {
+
+	return sc.PubJSWith(ctx, &pb.PubJSMsg{
+		Topic:   topic,
+		Msg:     data,
+		AckMode: ackMode,
+	})
+}
+
+// PubJSWith publishes pubJSMsg to JetStream, and returns the sidecar's
+// response. Set its IdempotencyKey to have JetStream store retries of the
+// same message only once. Those come back with PubAck.Duplicate set.
+func (sc *SC) PubJSWith(ctx context.Context, pubJSMsg *pb.PubJSMsg) (*pb.PubJSMsgResponse, error) {
+
+	pubJSMsg.Header = sc.newHeader(pb.MsgType_MSG_TYPE_PUB_JS)
+	topic := pubJSMsg.Topic
+
+	pubJSRsp, err := sc.Client.PubJS(ctx, pubJSMsg)
+	sc.Logger.Log("PubJS message sent: %s\n", pubJSMsg)
+	if err != nil {
+		sc.Logger.Log("Could not publish to JetStream topic: %s %v\n", topic, err)
+		return nil, err
+	}
+
+	sc.Logger.Log("PubJS rsp received: %s\n", pubJSRsp)
+
+	if pubJSRsp.RspHeader.Status != uint32(pb.Status_OK) {
+		return nil, fmt.Errorf("Error: received while publishing to JetStream topic: %s err: %s",
+			topic, pb.Status_name[int32(pubJSRsp.RspHeader.Status)])
+	}
+
+	return pubJSRsp, nil
+}
+
+// PubAcks delivers the results of PubJSAsync, until ctx is done.
+// Results for publishes made before the call are delivered too.
+func (sc *SC) PubAcks(ctx context.Context) (<-chan *pb.PubAckResult, error) {
+
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_PUB_ACKS)
+
+	pubAcksMsg := pb.PubAcksMsg{
+		Header: header,
+	}
+
+	stream, err := sc.Client.PubAcks(sc.streamContext(ctx), &pubAcksMsg)
+	sc.Logger.Log("PubAcks message sent: %s\n", &pubAcksMsg)
+	if err != nil {
+		sc.Logger.Log("Could not stream pub acks: %v\n", err)
+		return nil, err
+	}
+
+	results := make(chan *pb.PubAckResult)
+
+	go func() {
+		defer close(results)
+
+		for {
+			result, err := stream.Recv()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil {
+					sc.Logger.Log("Error receiving from pub acks stream: %v\n", err)
+				}
+				return
+			}
+
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
@@ -0,0 +1,164 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authMetadataKey = "authorization"
+	bearerPrefix    = "Bearer "
+
+	registerMethod = "/messages.Sidecar/Register"
+)
+
+// session holds the token the sidecar gave us when we registered. Every
+// call after that sends it along, to prove it comes from us.
+type session struct {
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+
+	// renew registers again. It is called when the sidecar no longer
+	// takes our token, say because keepalives failed for longer than
+	// the token lasts.
+	renew    func() error
+	renewing atomic.Bool
+}
+
+func (s *session) set(token string, expiresAt time.Time) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = token
+	s.expiresAt = expiresAt
+}
+
+func (s *session) get() string {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.token
+}
+
+// ttl is how long the token we have now is good for.
+func (s *session) ttl() time.Duration {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == "" {
+		return 0
+	}
+
+	return time.Until(s.expiresAt)
+}
+
+func attach(ctx context.Context, token string) context.Context {
+
+	if token == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, authMetadataKey, bearerPrefix+token)
+}
+
+// rejected registers again if the sidecar did not take token.
+func (s *session) rejected(token string, err error) {
+
+	if status.Code(err) != codes.Unauthenticated {
+		return
+	}
+
+	s.registerAgain(token, err)
+}
+
+// registerAgain registers again, since the sidecar no longer knows us by
+// the registration that got us token. Calls that fail together register
+// only once, and calls made while registering do not register again.
+// The call that failed is not retried. It was sent with a service ID that
+// is no longer ours.
+func (s *session) registerAgain(token string, err error) {
+
+	if s.renew == nil {
+		return
+	}
+
+	if !s.renewing.CompareAndSwap(false, true) {
+		return
+	}
+	defer s.renewing.Store(false)
+
+	// Some other call registered again already.
+	if s.get() != token {
+		return
+	}
+
+	fmt.Printf("Registration rejected by sidecar - registering again\n\terr: %v\n", err)
+	if err = s.renew(); err != nil {
+		fmt.Printf("Error registering again: %v\n", err)
+	}
+}
+
+func (s *session) unary(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	token := s.get()
+
+	err := invoker(attach(ctx, token), method, req, reply, cc, opts...)
+	if method != registerMethod {
+		s.rejected(token, err)
+	}
+
+	return err
+}
+
+func (s *session) stream(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+
+	token := s.get()
+
+	cs, err := streamer(attach(ctx, token), desc, cc, method, opts...)
+	if err != nil {
+		s.rejected(token, err)
+		return nil, err
+	}
+
+	return &sessionClientStream{cs, s, token}, nil
+}
+
+// sessionClientStream watches for the sidecar rejecting our token, which
+// it does on the first message of the stream.
+type sessionClientStream struct {
+	grpc.ClientStream
+	session *session
+	token   string
+}
+
+func (cs *sessionClientStream) RecvMsg(m interface{}) error {
+
+	err := cs.ClientStream.RecvMsg(m)
+	if err != nil {
+		cs.session.rejected(cs.token, err)
+	}
+
+	return err
+}
+
+func (s *session) dialOptions() []grpc.DialOption {
+
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(s.unary),
+		grpc.WithChainStreamInterceptor(s.stream),
+	}
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/find-in-docs/sidecar/pkg/config"
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSessionRenewal(t *testing.T) {
+
+	config.Load()
+
+	sc, err := InitSidecar("testing", nil)
+	if err != nil {
+		t.Fatalf("Error initializing sidecar.\n\terr: %v\n", err)
+	}
+	defer sc.Deregister(context.Background())
+
+	servId := sc.newHeader(pb.MsgType_MSG_TYPE_KEEPALIVE).ServId
+
+	// As if keepalives failed for longer than the session lasts.
+	sc.session.set("expired", time.Now())
+
+	if err = sc.KeepAlive(context.Background()); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("Expected an expired session to be rejected. Got: %v\n", err)
+	}
+
+	if token := sc.session.get(); token == "expired" || token == "" {
+		t.Errorf("Expected a new session token. Got: %s\n", token)
+	}
+	if newServId := sc.newHeader(pb.MsgType_MSG_TYPE_KEEPALIVE).ServId; bytes.Equal(newServId, servId) {
+		t.Errorf("Expected to register again with a new service ID\n")
+	}
+
+	if err = sc.Pub(context.Background(), "search.testdata.v1", []byte("after renewal"), nil); err != nil {
+		t.Errorf("Error publishing after registering again.\n\terr: %v\n", err)
+	}
+}
+
+func TestLeaseRenewal(t *testing.T) {
+
+	config.Load()
+
+	sc, err := InitSidecar("testing", nil)
+	if err != nil {
+		t.Fatalf("Error initializing sidecar.\n\terr: %v\n", err)
+	}
+	defer sc.Deregister(context.Background())
+
+	// As if our lease ran out. The sidecar forgets us, but our session
+	// token is still good.
+	header := sc.newHeader(pb.MsgType_MSG_TYPE_DEREG)
+	if _, err = sc.Client.Deregister(context.Background(), &pb.DeregistrationMsg{Header: header}); err != nil {
+		t.Fatalf("Error deregistering behind the client's back.\n\terr: %v\n", err)
+	}
+
+	if err = sc.KeepAlive(context.Background()); err == nil {
+		t.Fatalf("Expected a keepalive for an expired lease to be rejected\n")
+	}
+
+	if newServId := sc.newHeader(pb.MsgType_MSG_TYPE_KEEPALIVE).ServId; bytes.Equal(newServId, header.ServId) {
+		t.Errorf("Expected to register again with a new service ID\n")
+	}
+
+	if err = sc.KeepAlive(context.Background()); err != nil {
+		t.Errorf("Error sending keepalive after registering again.\n\terr: %v\n", err)
+	}
+}
@@ -0,0 +1,282 @@
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultTLSReloadPeriod = 10 * time.Second
+)
+
+// TLS settings in the sidecar-config look like:
+//
+//	tls:
+//	  reloadPeriod: 10s
+//	  server:
+//	    certFile: "/mnt/tls/tls.crt"
+//	    keyFile: "/mnt/tls/tls.key"
+//	    clientCAFile: "/mnt/tls/ca.crt"
+//	  client:
+//	    enabled: true
+//	    caFile: "/mnt/tls/ca.crt"
+//	    certFile: "/mnt/tls/client.crt"
+//	    keyFile: "/mnt/tls/client.key"
+//	    serverName: "sidecar"
+//
+// Setting a clientCAFile on the server requires clients to present a
+// certificate it signed. Clients without a caFile verify the server against
+// the system roots. The files are read again once they change on disk, so
+// that mounted secrets can be rotated without a restart.
+
+// fileStamp tells whether a file changed since it was last read.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func stampFiles(files ...string) ([]fileStamp, error) {
+
+	stamps := make([]fileStamp, len(files))
+	for i, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		stamps[i] = fileStamp{info.ModTime(), info.Size()}
+	}
+
+	return stamps, nil
+}
+
+// reloader keeps what it loaded from some files, and loads it again once
+// they change. If they cannot be loaded, what was loaded before is kept,
+// so that a secret caught half written does not break new connections.
+type reloader struct {
+	files  []string
+	period time.Duration
+	load   func() (interface{}, error)
+
+	mu      sync.Mutex
+	value   interface{}
+	stamps  []fileStamp
+	checked time.Time
+}
+
+func newReloader(period time.Duration, load func() (interface{}, error), files ...string) (*reloader, error) {
+
+	r := &reloader{
+		files:  files,
+		period: period,
+		load:   load,
+	}
+
+	if err := r.reloadLocked(time.Now()); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *reloader) reloadLocked(now time.Time) error {
+
+	r.checked = now
+
+	stamps, err := stampFiles(r.files...)
+	if err != nil {
+		return err
+	}
+
+	if r.value != nil && equalStamps(stamps, r.stamps) {
+		return nil
+	}
+
+	value, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	r.value = value
+	r.stamps = stamps
+
+	return nil
+}
+
+func equalStamps(a, b []fileStamp) bool {
+
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].modTime.Equal(b[i].modTime) || a[i].size != b[i].size {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *reloader) get() interface{} {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now := time.Now(); now.Sub(r.checked) > r.period {
+		if err := r.reloadLocked(now); err != nil {
+			fmt.Printf("Error reloading %v - keeping what was loaded before\n\terr: %v\n", r.files, err)
+		}
+	}
+
+	return r.value
+}
+
+func certReloader(period time.Duration, certFile, keyFile string) (*reloader, error) {
+
+	return newReloader(period, func() (interface{}, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading certificate: %s key: %s\n\terr: %w", certFile, keyFile, err)
+		}
+		return &cert, nil
+	}, certFile, keyFile)
+}
+
+func caReloader(period time.Duration, caFile string) (*reloader, error) {
+
+	return newReloader(period, func() (interface{}, error) {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading CA file: %s\n\terr: %w", caFile, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("Error - no certificates in CA file: %s\n", caFile)
+		}
+		return pool, nil
+	}, caFile)
+}
+
+func reloadPeriod() time.Duration {
+
+	period := viper.GetDuration("tls.reloadPeriod")
+	if period == 0 {
+		period = defaultTLSReloadPeriod
+	}
+
+	return period
+}
+
+// ServerTLS returns the server TLS config under key in the sidecar-config,
+// or nil if it has no certFile.
+func ServerTLS(key string) (*tls.Config, error) {
+
+	certFile := viper.GetString(key + ".certFile")
+	if certFile == "" {
+		return nil, nil
+	}
+
+	period := reloadPeriod()
+
+	certs, err := certReloader(period, certFile, viper.GetString(key+".keyFile"))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return certs.get().(*tls.Certificate), nil
+		},
+	}
+
+	clientCAFile := viper.GetString(key + ".clientCAFile")
+	if clientCAFile == "" {
+		return cfg, nil
+	}
+
+	clientCAs, err := caReloader(period, clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each handshake gets the client CAs as they are on disk now.
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		c := cfg.Clone()
+		c.GetConfigForClient = nil
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+		c.ClientCAs = clientCAs.get().(*x509.CertPool)
+		return c, nil
+	}
+
+	return cfg, nil
+}
+
+// ClientTLS returns the client TLS config under key in the sidecar-config,
+// or nil if it is not enabled and has no caFile or certFile.
+func ClientTLS(key string) (*tls.Config, error) {
+
+	caFile := viper.GetString(key + ".caFile")
+	certFile := viper.GetString(key + ".certFile")
+	if !viper.GetBool(key+".enabled") && caFile == "" && certFile == "" {
+		return nil, nil
+	}
+
+	period := reloadPeriod()
+
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: viper.GetString(key + ".serverName"),
+	}
+
+	if certFile != "" {
+		certs, err := certReloader(period, certFile, viper.GetString(key+".keyFile"))
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return certs.get().(*tls.Certificate), nil
+		}
+	}
+
+	if caFile == "" {
+		return cfg, nil
+	}
+
+	rootCAs, err := caReloader(period, caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Go only verifies against the RootCAs set up front. To pick up a rotated
+	// CA file, the server certificate is verified here instead, the same way.
+	cfg.InsecureSkipVerify = true
+	cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("Error - server sent no certificate")
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, cert := range cs.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+			DNSName:       cs.ServerName,
+			Roots:         rootCAs.get().(*x509.CertPool),
+			Intermediates: intermediates,
+		})
+		return err
+	}
+
+	return cfg, nil
+}
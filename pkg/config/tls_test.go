@@ -0,0 +1,181 @@
+package config
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error creating CA\n\terr: %v\n", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+
+	return &testCA{cert, key}
+}
+
+// issue writes a certificate for name signed by the CA, and its key.
+func (ca *testCA) issue(t *testing.T, name, certFile, keyFile string) {
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatalf("Error creating certificate\n\terr: %v\n", err)
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+
+	writePEM(t, certFile, "CERTIFICATE", der)
+	writePEM(t, keyFile, "EC PRIVATE KEY", keyDer)
+}
+
+func (ca *testCA) write(t *testing.T, caFile string) {
+
+	writePEM(t, caFile, "CERTIFICATE", ca.cert.Raw)
+}
+
+func writePEM(t *testing.T, file, typ string, der []byte) {
+
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0600); err != nil {
+		t.Fatalf("Error writing: %s\n\terr: %v\n", file, err)
+	}
+}
+
+// handshake returns the client's view of the connection, once both
+// ends agree on it.
+func handshake(serverCfg, clientCfg *tls.Config) (*tls.ConnectionState, error) {
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverConn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer serverConn.Close()
+		serverErr <- tls.Server(serverConn, serverCfg).Handshake()
+	}()
+
+	clientConn, err := net.Dial("tcp", lis.Addr().String())
+	if err != nil {
+		return nil, err
+	}
+	defer clientConn.Close()
+
+	client := tls.Client(clientConn, clientCfg)
+	if err := client.Handshake(); err != nil {
+		return nil, err
+	}
+
+	// TLS 1.3 clients finish before the server checked their certificate.
+	if err := <-serverErr; err != nil {
+		return nil, err
+	}
+
+	cs := client.ConnectionState()
+	return &cs, nil
+}
+
+func TestTLSReload(t *testing.T) {
+
+	dir := t.TempDir()
+	file := func(name string) string { return filepath.Join(dir, name) }
+
+	ca := newTestCA(t, "ca1")
+	ca.write(t, file("ca.crt"))
+	ca.issue(t, "sidecar", file("server.crt"), file("server.key"))
+	ca.issue(t, "client", file("client.crt"), file("client.key"))
+
+	// A negative reload period checks the files on every handshake.
+	viper.Set("tls.reloadPeriod", -1)
+	viper.Set("tls.server.certFile", file("server.crt"))
+	viper.Set("tls.server.keyFile", file("server.key"))
+	viper.Set("tls.server.clientCAFile", file("ca.crt"))
+	viper.Set("tls.client.caFile", file("ca.crt"))
+	viper.Set("tls.client.certFile", file("client.crt"))
+	viper.Set("tls.client.keyFile", file("client.key"))
+	viper.Set("tls.client.serverName", "sidecar")
+	defer viper.Reset()
+
+	serverCfg, err := ServerTLS("tls.server")
+	if err != nil {
+		t.Fatalf("Error loading server TLS\n\terr: %v\n", err)
+	}
+	clientCfg, err := ClientTLS("tls.client")
+	if err != nil {
+		t.Fatalf("Error loading client TLS\n\terr: %v\n", err)
+	}
+
+	if _, err = handshake(serverCfg, clientCfg); err != nil {
+		t.Fatalf("Expected mutual TLS to work\n\terr: %v\n", err)
+	}
+
+	noCert := clientCfg.Clone()
+	noCert.GetClientCertificate = nil
+	if _, err = handshake(serverCfg, noCert); err == nil {
+		t.Errorf("Expected a client without a certificate to be rejected\n")
+	}
+
+	// Rotate to a new CA, with certificates it signed.
+	ca = newTestCA(t, "ca2")
+	ca.write(t, file("ca.crt"))
+	ca.issue(t, "sidecar", file("server.crt"), file("server.key"))
+	ca.issue(t, "client", file("client.crt"), file("client.key"))
+
+	cs, err := handshake(serverCfg, clientCfg)
+	if err != nil {
+		t.Fatalf("Expected mutual TLS to work after rotation\n\terr: %v\n", err)
+	}
+	if issuer := cs.PeerCertificates[0].Issuer.CommonName; issuer != "ca2" {
+		t.Errorf("Expected the rotated server certificate. Got one issued by: %s\n", issuer)
+	}
+
+	if cfg, _ := ServerTLS("tls.none"); cfg != nil {
+		t.Errorf("Expected no TLS without a certFile\n")
+	}
+}
@@ -0,0 +1,191 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/find-in-docs/sidecar/pkg/log"
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/google/uuid"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultAckTokenTTL = 10 * time.Minute
+	ackSweepPeriod     = time.Minute
+)
+
+type ackKind int
+
+const (
+	ackAck ackKind = iota
+	ackNak
+	ackInProgress
+	ackTerm
+)
+
+func (k ackKind) String() string {
+
+	return [...]string{"Ack", "Nak", "InProgress", "Term"}[k]
+}
+
+// pendingAck is a JetStream message that was delivered to a client,
+// and that the client did not settle yet.
+type pendingAck struct {
+	servId      string
+	msg         *nats.Msg
+	deliveredAt time.Time
+}
+
+// pendingAcks maps the ack tokens handed to clients to their messages.
+// Tokens the client never settles are forgotten after a while. By then
+// JetStream has delivered the message again, with a new token.
+type pendingAcks struct {
+	mu    sync.Mutex
+	msgs  map[string]*pendingAck
+	swept time.Time
+}
+
+func newPendingAcks() *pendingAcks {
+
+	return &pendingAcks{
+		msgs:  make(map[string]*pendingAck),
+		swept: time.Now(),
+	}
+}
+
+// add keeps the message until the client settles it, and returns its ack token.
+func (p *pendingAcks) add(servId string, m *nats.Msg) string {
+
+	token := uuid.NewString()
+	now := time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if now.Sub(p.swept) > ackSweepPeriod {
+		ttl := viper.GetDuration("nats.jetstream.ackTokenTTL")
+		if ttl <= 0 {
+			ttl = defaultAckTokenTTL
+		}
+
+		for t, a := range p.msgs {
+			if now.Sub(a.deliveredAt) > ttl {
+				delete(p.msgs, t)
+			}
+		}
+		p.swept = now
+	}
+
+	p.msgs[token] = &pendingAck{
+		servId:      servId,
+		msg:         m,
+		deliveredAt: now,
+	}
+
+	return token
+}
+
+// get returns the message for a token handed to the client with servId.
+func (p *pendingAcks) get(servId []byte, token string) (*nats.Msg, error) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	a, ok := p.msgs[token]
+	if !ok || a.servId != string(servId) {
+		return nil, fmt.Errorf("Error - unknown ack token: %s\n", token)
+	}
+
+	return a.msg, nil
+}
+
+// settled forgets a token once its message was settled. A message that is
+// still in progress keeps its token, and gets more time before it is forgotten.
+func (p *pendingAcks) settled(token string, kind ackKind) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if kind == ackInProgress {
+		if a, ok := p.msgs[token]; ok {
+			a.deliveredAt = time.Now()
+		}
+		return
+	}
+
+	delete(p.msgs, token)
+}
+
+// releaseClient forgets the client's tokens, and returns their messages.
+func (p *pendingAcks) releaseClient(servId []byte) []*nats.Msg {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var msgs []*nats.Msg
+	for t, a := range p.msgs {
+		if a.servId == string(servId) {
+			msgs = append(msgs, a.msg)
+			delete(p.msgs, t)
+		}
+	}
+
+	return msgs
+}
+
+func (subs *Subs) Settle(ctx context.Context, logger *log.Logger, servId []byte,
+	in *pb.AckMsg, kind ackKind) (*pb.AckMsgResponse, error) {
+
+	token := in.GetAckToken()
+
+	m, err := subs.acks.get(servId, token)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case ackAck:
+		// Wait for JetStream to confirm. The client takes
+		// an OK to mean the message is gone for good.
+		err = m.AckSync(nats.Context(ctx))
+	case ackNak:
+		if delay := in.GetNakDelay().AsDuration(); delay > 0 {
+			err = m.NakWithDelay(delay)
+		} else {
+			err = m.Nak()
+		}
+	case ackInProgress:
+		err = m.InProgress()
+	case ackTerm:
+		err = m.Term()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error - %s failed for ack token: %s\n\terr: %w", kind, token, err)
+	}
+
+	subs.acks.settled(token, kind)
+
+	ackMsgRsp := &pb.AckMsgResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_ACK_RSP,
+			SrcServType: serviceType(),
+			DstServType: in.Header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg: "OK",
+	}
+
+	logger.Log("%s sent for ack token: %s\n", kind, token)
+
+	return ackMsgRsp, nil
+}
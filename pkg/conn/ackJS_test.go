@@ -0,0 +1,42 @@
+package conn
+
+import (
+	"testing"
+
+	"github.com/nats-io/nats.go"
+)
+
+func TestPendingAcks(t *testing.T) {
+
+	acks := newPendingAcks()
+	m := &nats.Msg{Subject: "test.ack"}
+
+	token := acks.add("client", m)
+
+	// Only the client that got the message can settle it.
+	if _, err := acks.get([]byte("other"), token); err == nil {
+		t.Errorf("Error: another client was able to settle the message\n")
+	}
+
+	got, err := acks.get([]byte("client"), token)
+	if err != nil || got != m {
+		t.Errorf("Error getting message for ack token\n\terr: %v\n", err)
+	}
+
+	// A message in progress can still be settled later.
+	acks.settled(token, ackInProgress)
+	if _, err := acks.get([]byte("client"), token); err != nil {
+		t.Errorf("Error: ack token forgotten after InProgress\n\terr: %v\n", err)
+	}
+
+	acks.settled(token, ackAck)
+	if _, err := acks.get([]byte("client"), token); err == nil {
+		t.Errorf("Error: ack token still known after Ack\n")
+	}
+
+	acks.add("client", m)
+	acks.add("other", m)
+	if msgs := acks.releaseClient([]byte("client")); len(msgs) != 1 || len(acks.msgs) != 1 {
+		t.Errorf("Error releasing client. Got %d messages, %d left\n", len(msgs), len(acks.msgs))
+	}
+}
@@ -3,51 +3,217 @@
 //   - This code runs on the sidecar service, so that it does not have to be duplicated
 //     across all services. For that reason, the Circuit function type has been changed
 //     to take a message and return a message.
+//   - The breaker has a half-open state. Only a limited number of probes go through
+//     while half-open, and it takes a number of successful probes to close again.
+//   - The breaker can also open on the failure rate over a sliding window.
 package conn
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"sync"
 	"time"
 )
 
+const (
+	defaultOpenTimeout       = 2 * time.Second
+	defaultMaxOpenTimeout    = 2 * time.Minute
+	defaultHalfOpenMaxProbes = 1
+	defaultSuccessThreshold  = 1
+
+	failureRateBuckets = 10
+)
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// CircuitOpenError is returned without calling the circuit while
+// the breaker is open. RetryIn is the time left until the next probe.
+type CircuitOpenError struct {
+	RetryIn time.Duration
+}
+
+func (e *CircuitOpenError) Error() string {
+
+	return fmt.Sprintf("service unreachable - circuit open, retry in %v", e.RetryIn)
+}
+
+// BreakerSettings configures a breaker. Zero values take the defaults.
+// FailureThreshold and FailureRate are both optional, but a breaker
+// without either of them never opens.
+type BreakerSettings struct {
+	FailureThreshold  uint
+	SuccessThreshold  uint
+	HalfOpenMaxProbes uint
+	OpenTimeout       time.Duration
+	MaxOpenTimeout    time.Duration
+
+	FailureRateWindow      time.Duration
+	FailureRate            float64
+	FailureRateMinRequests uint
+}
+
+func (s *BreakerSettings) setDefaults() {
+
+	if s.SuccessThreshold == 0 {
+		s.SuccessThreshold = defaultSuccessThreshold
+	}
+
+	if s.HalfOpenMaxProbes == 0 {
+		s.HalfOpenMaxProbes = defaultHalfOpenMaxProbes
+	}
+
+	if s.OpenTimeout <= 0 {
+		s.OpenTimeout = defaultOpenTimeout
+	}
+
+	if s.MaxOpenTimeout < s.OpenTimeout {
+		s.MaxOpenTimeout = defaultMaxOpenTimeout
+		if s.MaxOpenTimeout < s.OpenTimeout {
+			s.MaxOpenTimeout = s.OpenTimeout
+		}
+	}
+}
+
 func Breaker(circuit Circuit, failureThreshold uint) Circuit {
-	// Number of failures after the first
-	var consecutiveFailures int = 0
 
-	// Time of the last interaction with the downstream service
-	var lastAttempt = time.Now()
+	return BreakerWithSettings(circuit, BreakerSettings{
+		FailureThreshold: failureThreshold,
+	})
+}
+
+func BreakerWithSettings(circuit Circuit, settings BreakerSettings) Circuit {
+
+	settings.setDefaults()
+
+	state := breakerClosed
+
+	// Number of failures in a row while closed
+	var consecutiveFailures uint
+
+	// Number of times the breaker opened without closing in between.
+	// The open timeout doubles each time.
+	var timesOpened uint
+
+	// When the breaker goes from open to half-open
+	var retryAt time.Time
 
-	var m sync.RWMutex
+	var probes, probeSuccesses uint
+
+	window := newFailureWindow(settings.FailureRateWindow)
+
+	var m sync.Mutex
+
+	openCircuit := func(now time.Time) {
+		timeout := settings.OpenTimeout
+		for i := uint(0); i < timesOpened && timeout < settings.MaxOpenTimeout; i++ {
+			timeout *= 2
+		}
+		if timeout > settings.MaxOpenTimeout {
+			timeout = settings.MaxOpenTimeout
+		}
+
+		state = breakerOpen
+		timesOpened++
+		retryAt = now.Add(timeout)
+	}
+
+	closeCircuit := func() {
+		state = breakerClosed
+		timesOpened = 0
+		consecutiveFailures = 0
+		window.reset()
+	}
+
+	tripped := func(now time.Time) bool {
+		if settings.FailureThreshold > 0 && consecutiveFailures >= settings.FailureThreshold {
+			return true
+		}
+
+		if settings.FailureRate > 0 && window != nil {
+			requests, failures := window.counts(now)
+			if requests > 0 && requests >= settings.FailureRateMinRequests &&
+				float64(failures)/float64(requests) >= settings.FailureRate {
+				return true
+			}
+		}
+
+		return false
+	}
 
 	// Construct and return the Circuit closure
 	return func(ctx context.Context, msg *Message) (*Message, error) {
-		m.RLock()
+		m.Lock()
+
+		now := time.Now()
 
-		d := consecutiveFailures - int(failureThreshold)
+		if state == breakerOpen {
+			if now.Before(retryAt) {
+				m.Unlock()
+				return &Message{}, &CircuitOpenError{RetryIn: retryAt.Sub(now)}
+			}
 
-		if d >= 0 {
-			shouldRetryAt := lastAttempt.Add(time.Second * 2 << d)
+			state = breakerHalfOpen
+			probes = 0
+			probeSuccesses = 0
+		}
 
-			if !time.Now().After(shouldRetryAt) {
-				m.RUnlock()
-				return &Message{}, errors.New("service unreachable")
+		probing := state == breakerHalfOpen
+		if probing {
+			// Let only a few callers find out if the service is back.
+			// Everyone else waits for them.
+			if probes >= settings.HalfOpenMaxProbes {
+				m.Unlock()
+				return &Message{}, &CircuitOpenError{RetryIn: settings.OpenTimeout}
 			}
+			probes++
 		}
 
-		m.RUnlock()
+		m.Unlock()
 
 		response, err := circuit(ctx, msg) // Issue request proper
 
 		m.Lock() // Lock around shared resources
 		defer m.Unlock()
 
-		lastAttempt = time.Now() // Record time of attempt
+		now = time.Now()
+
+		if probing {
+			probes--
+
+			// The breaker may have re-opened because of another probe.
+			if state != breakerHalfOpen {
+				return response, err
+			}
+
+			if err != nil {
+				openCircuit(now)
+				return response, err
+			}
+
+			probeSuccesses++
+			if probeSuccesses >= settings.SuccessThreshold {
+				closeCircuit()
+			}
+
+			return response, nil
+		}
+
+		window.record(now, err != nil)
 
 		if err != nil { // Circuit returned an error,
 			consecutiveFailures++ // so we count the failure
-			return response, err  // and return
+
+			if state == breakerClosed && tripped(now) {
+				openCircuit(now)
+			}
+
+			return response, err // and return
 		}
 
 		consecutiveFailures = 0 // Reset failures counter
@@ -55,3 +221,81 @@ func Breaker(circuit Circuit, failureThreshold uint) Circuit {
 		return response, nil
 	}
 }
+
+// failureWindow counts requests and failures over a sliding window,
+// using a ring of buckets so that old results age out.
+type failureWindow struct {
+	bucketWidth time.Duration
+	buckets     [failureRateBuckets]failureBucket
+}
+
+type failureBucket struct {
+	start    time.Time
+	requests uint
+	failures uint
+}
+
+func newFailureWindow(width time.Duration) *failureWindow {
+
+	if width <= 0 {
+		return nil
+	}
+
+	bucketWidth := width / failureRateBuckets
+	if bucketWidth <= 0 {
+		bucketWidth = 1
+	}
+
+	return &failureWindow{
+		bucketWidth: bucketWidth,
+	}
+}
+
+func (w *failureWindow) bucket(now time.Time) *failureBucket {
+
+	start := now.Truncate(w.bucketWidth)
+	b := &w.buckets[(start.UnixNano()/int64(w.bucketWidth))%failureRateBuckets]
+
+	if !b.start.Equal(start) {
+		*b = failureBucket{start: start}
+	}
+
+	return b
+}
+
+func (w *failureWindow) record(now time.Time, failed bool) {
+
+	if w == nil {
+		return
+	}
+
+	b := w.bucket(now)
+	b.requests++
+	if failed {
+		b.failures++
+	}
+}
+
+func (w *failureWindow) counts(now time.Time) (uint, uint) {
+
+	oldest := now.Add(-w.bucketWidth * failureRateBuckets)
+
+	var requests, failures uint
+	for _, b := range w.buckets {
+		if b.start.After(oldest) {
+			requests += b.requests
+			failures += b.failures
+		}
+	}
+
+	return requests, failures
+}
+
+func (w *failureWindow) reset() {
+
+	if w == nil {
+		return
+	}
+
+	w.buckets = [failureRateBuckets]failureBucket{}
+}
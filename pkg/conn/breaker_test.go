@@ -0,0 +1,114 @@
+package conn
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errTestCircuit = errors.New("request failed")
+
+func TestBreakerHalfOpen(t *testing.T) {
+
+	var mu sync.Mutex
+	fail := true
+	release := make(chan struct{})
+
+	circuit := func(ctx context.Context, msg *Message) (*Message, error) {
+		if msg.topic == "slow" {
+			<-release
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if fail {
+			return nil, errTestCircuit
+		}
+		return &Message{}, nil
+	}
+
+	breaker := BreakerWithSettings(circuit, BreakerSettings{
+		FailureThreshold:  2,
+		SuccessThreshold:  2,
+		HalfOpenMaxProbes: 1,
+		OpenTimeout:       50 * time.Millisecond,
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if _, err := breaker(ctx, &Message{}); !errors.Is(err, errTestCircuit) {
+			t.Fatalf("Expected request to fail. Got: %v\n", err)
+		}
+	}
+
+	var circuitOpen *CircuitOpenError
+	if _, err := breaker(ctx, &Message{}); !errors.As(err, &circuitOpen) {
+		t.Fatalf("Expected circuit to be open. Got: %v\n", err)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+
+	mu.Lock()
+	fail = false
+	mu.Unlock()
+
+	// While the one probe allowed is in flight, everyone else is turned away.
+	done := make(chan error)
+	go func() {
+		_, err := breaker(ctx, &Message{topic: "slow"})
+		done <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	if _, err := breaker(ctx, &Message{}); !errors.As(err, &circuitOpen) {
+		t.Errorf("Expected second probe to be turned away. Got: %v\n", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Expected probe to succeed. Got: %v\n", err)
+	}
+
+	// One more successful probe closes the circuit.
+	if _, err := breaker(ctx, &Message{}); err != nil {
+		t.Fatalf("Expected probe to succeed. Got: %v\n", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := breaker(ctx, &Message{}); err != nil {
+			t.Errorf("Expected circuit to be closed. Got: %v\n", err)
+		}
+	}
+}
+
+func TestBreakerFailureRate(t *testing.T) {
+
+	n := 0
+	circuit := func(ctx context.Context, msg *Message) (*Message, error) {
+		n++
+		// Every other request fails, so there are never two failures in a row.
+		if n%2 == 0 {
+			return nil, errTestCircuit
+		}
+		return &Message{}, nil
+	}
+
+	breaker := BreakerWithSettings(circuit, BreakerSettings{
+		FailureThreshold:       2,
+		FailureRateWindow:      time.Minute,
+		FailureRate:            0.5,
+		FailureRateMinRequests: 6,
+	})
+
+	ctx := context.Background()
+	for i := 0; i < 6; i++ {
+		breaker(ctx, &Message{})
+	}
+
+	var circuitOpen *CircuitOpenError
+	if _, err := breaker(ctx, &Message{}); !errors.As(err, &circuitOpen) {
+		t.Errorf("Expected circuit to open on failure rate. Got: %v\n", err)
+	}
+}
@@ -3,20 +3,88 @@ package conn
 import (
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/find-in-docs/sidecar/pkg/config"
 	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
 )
 
 type Conn struct {
 	nc  *nats.Conn
-	js  nats.JetStreamContext
 	Url string
+
+	jsMu sync.Mutex
+	js   nats.JetStreamContext
+}
+
+// NATS credentials in the sidecar-config look like one of:
+//
+//	nats:
+//	  auth:
+//	    user: "sidecar"
+//	    password: "secret"
+//	    token: "secret"
+//	    nkeySeedFile: "/mnt/nats/sidecar.nk"
+//	    credsFile: "/mnt/nats/sidecar.creds"
+//	  tls:
+//	    caFile: "/mnt/nats/ca.crt"
+//	    certFile: "/mnt/nats/client.crt"
+//	    keyFile: "/mnt/nats/client.key"
+//
+// Only one way to authenticate may be set. The tls settings are the same as
+// the ones under tls.client, and the files are reloaded the same way.
+func natsOptions() ([]nats.Option, error) {
+
+	var opts []nats.Option
+
+	if user := viper.GetString("nats.auth.user"); user != "" {
+		opts = append(opts, nats.UserInfo(user, viper.GetString("nats.auth.password")))
+	}
+
+	if token := viper.GetString("nats.auth.token"); token != "" {
+		opts = append(opts, nats.Token(token))
+	}
+
+	if seedFile := viper.GetString("nats.auth.nkeySeedFile"); seedFile != "" {
+		opt, err := nats.NkeyOptionFromSeed(seedFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading NKey seed file: %s\n\terr: %w", seedFile, err)
+		}
+		opts = append(opts, opt)
+	}
+
+	if credsFile := viper.GetString("nats.auth.credsFile"); credsFile != "" {
+		if _, err := os.Stat(credsFile); err != nil {
+			return nil, fmt.Errorf("Error reading NATS creds file: %s\n\terr: %w", credsFile, err)
+		}
+		opts = append(opts, nats.UserCredentials(credsFile))
+	}
+
+	if len(opts) > 1 {
+		return nil, fmt.Errorf("Error - more than one way to authenticate with NATS set in nats.auth\n")
+	}
+
+	tlsConfig, err := config.ClientTLS("nats.tls")
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts = append(opts, nats.Secure(tlsConfig))
+	}
+
+	return opts, nil
 }
 
 func NewNATSConn(url string) (*Conn, error) {
 
-	nc, err := nats.Connect(url, nats.RetryOnFailedConnect(true),
+	authOpts, err := natsOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	nc, err := nats.Connect(url, append(authOpts, nats.RetryOnFailedConnect(true),
 		// nats.MaxReconnects(10),   // Defaults to 60 attempts
 		nats.ReconnectWait(3*time.Second),  // Defaults to 2s
 		nats.DisconnectErrHandler(func(nc *nats.Conn, err error) {
@@ -27,24 +95,57 @@ func NewNATSConn(url string) (*Conn, error) {
 		}),
 		nats.ClosedHandler(func(nc *nats.Conn) {
 			fmt.Printf("Connection closed. Reason: %q\n", nc.LastError())
-		}))
+		}))...)
 
 	if err != nil {
 		return nil, fmt.Errorf("Error connecting to NATS server. err: %w", err)
 	}
 
-	c := Conn{nc, nil, url}
+	c := Conn{nc: nc, Url: url}
 
 	return &c, nil
 }
 
+// JetStream returns the JetStream context, creating it on first use.
+func (c *Conn) JetStream() (nats.JetStreamContext, error) {
+
+	c.jsMu.Lock()
+	defer c.jsMu.Unlock()
+
+	if c.js == nil {
+		js, err := c.nc.JetStream(nats.PublishAsyncMaxPending(256))
+		if err != nil {
+			return nil, fmt.Errorf("Error creating JetStream: %w", err)
+		}
+		c.js = js
+	}
+
+	return c.js, nil
+}
+
+// Subscribe returns an error instead of exiting, since the
+// topic may come from the client, and may not be valid.
 func (c *Conn) Subscribe(t string, f func(*nats.Msg)) (*nats.Subscription, error) {
 
 	s, err := c.nc.Subscribe(t, f)
 	if err != nil {
 		fmt.Printf("Error subscribing to NATS server:\n\tserver: %s\n\ttopic: %s\n\terr: %v\n",
 			c.Url, t, err)
-		os.Exit(-1)
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// QueueSubscribe returns an error instead of exiting, since the
+// queue group comes from the client, and may not be valid.
+func (c *Conn) QueueSubscribe(t, queue string, f func(*nats.Msg)) (*nats.Subscription, error) {
+
+	s, err := c.nc.QueueSubscribe(t, queue, f)
+	if err != nil {
+		fmt.Printf("Error subscribing to NATS server:\n\tserver: %s\n\ttopic: %s\n\tqueue: %s\n\terr: %v\n",
+			c.Url, t, queue, err)
+		return nil, err
 	}
 
 	return s, nil
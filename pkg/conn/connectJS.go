@@ -1,32 +1,47 @@
 package conn
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/nats-io/nats.go"
 	"github.com/spf13/viper"
 )
 
-func NewNATSConnJS(nc *nats.Conn) (nats.JetStreamContext, error) {
+// NewNATSConnJS sets up the stream and consumer in the sidecar-config, if
+// there is one. Streams and consumers made through the management RPCs are
+// left alone, even if their configuration is different.
+func NewNATSConnJS(c *Conn) (nats.JetStreamContext, error) {
 
-	streamName := viper.GetString("nats.jetstream.name")
-	js, err := nc.JetStream(nats.PublishAsyncMaxPending(256))
+	js, err := c.JetStream()
 	if err != nil {
-		return nil, fmt.Errorf("Error creating JetStream: %w", err)
+		return nil, err
+	}
+
+	streamName := viper.GetString("nats.jetstream.name")
+	if streamName == "" {
+		return js, nil
 	}
 
 	topic := viper.GetString("nats.jetstream.subject")
-	js.AddStream(&nats.StreamConfig{
+	_, err = js.AddStream(&nats.StreamConfig{
 		Name:     streamName,
 		Subjects: []string{topic},
 		Storage:  nats.FileStorage, // default: nats.FileStorage
 		MaxMsgs:  viper.GetInt64("nats.maxMsgs"),
 		NoAck:    false,
 	})
+	if err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+		return js, fmt.Errorf("Could not add stream %s: %w", streamName, err)
+	}
 
 	// We dont need to save the consumer info returned, since it is accessible
 	// from the NATS API
 	durableName := viper.GetString("nats.jetstream.consumer.durableName")
+	if durableName == "" {
+		return js, nil
+	}
+
 	_, err = js.AddConsumer(streamName, &nats.ConsumerConfig{
 		// A durable consumer will pick up where it left
 		// off on a re-connection from the subscriber.
@@ -34,7 +49,7 @@ func NewNATSConnJS(nc *nats.Conn) (nats.JetStreamContext, error) {
 		Durable:   durableName,
 		AckPolicy: nats.AckExplicitPolicy,
 	})
-	if err != nil {
+	if err != nil && !errors.Is(err, nats.ErrConsumerNameAlreadyInUse) {
 		return js, fmt.Errorf("Could not add consumer on stream %s: %w", streamName, err)
 	}
 
@@ -43,7 +58,12 @@ func NewNATSConnJS(nc *nats.Conn) (nats.JetStreamContext, error) {
 
 func (c *Conn) SubscribeJS(topic string, group string) (*nats.Subscription, error) {
 
-	s, err := c.js.PullSubscribe(topic, group, nats.PullMaxWaiting(128))
+	js, err := c.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := js.PullSubscribe(topic, group, nats.PullMaxWaiting(128))
 	if err != nil {
 		return nil, fmt.Errorf("Error creating pull subscriber: %w", err)
 	}
@@ -51,10 +71,18 @@ func (c *Conn) SubscribeJS(topic string, group string) (*nats.Subscription, erro
 	return s, nil
 }
 
-func (c *Conn) PublishJS(topic string, data []byte) error {
+// PublishJS publishes to JetStream, and returns once JetStream stored the message.
+func (c *Conn) PublishJS(topic string, data []byte) (*nats.PubAck, error) {
+
+	js, err := c.JetStream()
+	if err != nil {
+		return nil, err
+	}
 
-	ret1, ret2 := c.js.Publish(topic, data)
-	fmt.Printf("ret1 type: %T ret2 type: %T\n", ret1, ret2)
+	ack, err := js.Publish(topic, data)
+	if err != nil {
+		return nil, fmt.Errorf("Error publishing to JetStream with topic: %s\n\terr: %w", topic, err)
+	}
 
-	return nil
+	return ack, nil
 }
@@ -0,0 +1,236 @@
+package conn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nkeys"
+	"github.com/spf13/viper"
+)
+
+func startTestNATSServer(t *testing.T, opts *server.Options) *server.Server {
+
+	opts.Host = "127.0.0.1"
+	opts.Port = -1
+	opts.NoLog = true
+	opts.NoSigs = true
+
+	s, err := server.NewServer(opts)
+	if err != nil {
+		t.Fatalf("Error creating NATS server\n\terr: %v\n", err)
+	}
+
+	go s.Start()
+	if !s.ReadyForConnections(5 * time.Second) {
+		t.Fatalf("NATS server did not start\n")
+	}
+	t.Cleanup(s.Shutdown)
+
+	return s
+}
+
+// setConfig sets keys in the sidecar-config, and returns a func that
+// puts back what they were. Other keys are left alone.
+func setConfig(settings map[string]interface{}) func() {
+
+	saved := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		saved[k] = viper.Get(k)
+		viper.Set(k, v)
+	}
+
+	return func() {
+		for k, v := range saved {
+			viper.Set(k, v)
+		}
+	}
+}
+
+// connects tells whether NewNATSConn gets through to the server
+// with the given nats settings in the sidecar-config.
+func connects(t *testing.T, s *server.Server, nats map[string]string) bool {
+
+	settings := make(map[string]interface{}, len(nats))
+	for k, v := range nats {
+		settings["nats."+k] = v
+	}
+	defer setConfig(settings)()
+
+	natsConn, err := NewNATSConn(s.ClientURL())
+	if err != nil {
+		return false
+	}
+	defer natsConn.nc.Close()
+
+	return natsConn.nc.FlushTimeout(time.Second) == nil
+}
+
+func TestNATSAuth(t *testing.T) {
+
+	dir := t.TempDir()
+
+	s := startTestNATSServer(t, &server.Options{Username: "sidecar", Password: "secret"})
+	if !connects(t, s, map[string]string{"auth.user": "sidecar", "auth.password": "secret"}) {
+		t.Errorf("Expected to connect with user and password\n")
+	}
+	if connects(t, s, map[string]string{"auth.user": "sidecar", "auth.password": "wrong"}) {
+		t.Errorf("Expected a wrong password to be refused\n")
+	}
+
+	s = startTestNATSServer(t, &server.Options{Authorization: "token"})
+	if !connects(t, s, map[string]string{"auth.token": "token"}) {
+		t.Errorf("Expected to connect with a token\n")
+	}
+
+	userKey, _ := nkeys.CreateUser()
+	userPub, _ := userKey.PublicKey()
+	userSeed, _ := userKey.Seed()
+	seedFile := filepath.Join(dir, "sidecar.nk")
+	os.WriteFile(seedFile, userSeed, 0600)
+
+	s = startTestNATSServer(t, &server.Options{Nkeys: []*server.NkeyUser{{Nkey: userPub}}})
+	if !connects(t, s, map[string]string{"auth.nkeySeedFile": seedFile}) {
+		t.Errorf("Expected to connect with an NKey seed\n")
+	}
+
+	// A decentralized setup: the operator vouches for the account,
+	// which issues the user JWT in the creds file.
+	operatorKey, _ := nkeys.CreateOperator()
+	operatorPub, _ := operatorKey.PublicKey()
+	operatorJWT, _ := jwt.NewOperatorClaims(operatorPub).Encode(operatorKey)
+	operator, _ := jwt.DecodeOperatorClaims(operatorJWT)
+
+	accountKey, _ := nkeys.CreateAccount()
+	accountPub, _ := accountKey.PublicKey()
+	accountJWT, _ := jwt.NewAccountClaims(accountPub).Encode(operatorKey)
+
+	userJWT, _ := jwt.NewUserClaims(userPub).Encode(accountKey)
+	creds, _ := jwt.FormatUserConfig(userJWT, userSeed)
+	credsFile := filepath.Join(dir, "sidecar.creds")
+	os.WriteFile(credsFile, creds, 0600)
+
+	resolver := &server.MemAccResolver{}
+	resolver.Store(accountPub, accountJWT)
+	s = startTestNATSServer(t, &server.Options{
+		TrustedOperators: []*jwt.OperatorClaims{operator},
+		AccountResolver:  resolver,
+	})
+	if !connects(t, s, map[string]string{"auth.credsFile": credsFile}) {
+		t.Errorf("Expected to connect with a creds file\n")
+	}
+
+	defer setConfig(map[string]interface{}{
+		"nats.auth.token":     "token",
+		"nats.auth.credsFile": credsFile,
+	})()
+	if _, err := natsOptions(); err == nil {
+		t.Errorf("Expected an error with more than one way to authenticate\n")
+	}
+}
+
+// issueCert writes a certificate for 127.0.0.1 and its key, signed by the
+// CA, or self-signed if ca is nil. It returns the certificate and its key.
+func issueCert(t *testing.T, ca *x509.Certificate, caKey *ecdsa.PrivateKey,
+	isCA bool, certFile, keyFile string) (*x509.Certificate, *ecdsa.PrivateKey) {
+
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if isCA {
+		tmpl.IsCA = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+		tmpl.BasicConstraintsValid = true
+	}
+	if ca == nil {
+		ca, caKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Error creating certificate\n\terr: %v\n", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+
+	os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)
+	if keyFile != "" {
+		os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600)
+	}
+
+	return cert, key
+}
+
+func TestNATSTLS(t *testing.T) {
+
+	dir := t.TempDir()
+	file := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+
+	ca, caKey := issueCert(t, nil, nil, true, file("ca.crt"), "")
+	issueCert(t, ca, caKey, false, file("server.crt"), file("server.key"))
+	issueCert(t, ca, caKey, false, file("client.crt"), file("client.key"))
+	issueCert(t, nil, nil, true, file("other.crt"), "")
+
+	serverCert, err := tls.LoadX509KeyPair(file("server.crt"), file("server.key"))
+	if err != nil {
+		t.Fatalf("Error loading server certificate\n\terr: %v\n", err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+
+	// The server only takes clients with a certificate from the CA.
+	s := startTestNATSServer(t, &server.Options{
+		TLSConfig: &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{serverCert},
+			ClientAuth:   tls.RequireAndVerifyClientCert,
+			ClientCAs:    clientCAs,
+		},
+		TLSVerify:  true,
+		TLSTimeout: 2,
+	})
+
+	if !connects(t, s, map[string]string{
+		"tls.caFile":   file("ca.crt"),
+		"tls.certFile": file("client.crt"),
+		"tls.keyFile":  file("client.key"),
+	}) {
+		t.Errorf("Expected to connect with TLS and a client certificate\n")
+	}
+
+	if connects(t, s, map[string]string{"tls.caFile": file("ca.crt")}) {
+		t.Errorf("Expected a client without a certificate to be refused\n")
+	}
+
+	if connects(t, s, map[string]string{
+		"tls.caFile":   file("other.crt"),
+		"tls.certFile": file("client.crt"),
+		"tls.keyFile":  file("client.key"),
+	}) {
+		t.Errorf("Expected a server certificate from another CA to be refused\n")
+	}
+
+	if connects(t, s, map[string]string{}) {
+		t.Errorf("Expected a client without TLS to be refused\n")
+	}
+}
@@ -3,6 +3,11 @@
 //   - This code runs on the sidecar service, so that it does not have to be duplicated
 //     across all services. For that reason, the Circuit function type has been changed
 //     to take a message and return a message.
+//   - Calls that were collapsed get back a message marked as debounced, so that
+//     the sidecar can tell its client that the message was not sent.
+//   - DebounceLast makes every caller wait for the call that is actually made,
+//     instead of returning the result of the previous one. The call does not
+//     depend on any one caller, so a caller giving up does not fail the rest.
 package conn
 
 import (
@@ -11,7 +16,21 @@ import (
 	"time"
 )
 
-func (msg *Message) DebounceFirst(circuit Circuit, d time.Duration) Circuit {
+// debounced marks a copy of the result as not sent by this caller.
+func debounced(result *Message) *Message {
+
+	var m Message
+	if result != nil {
+		m = *result
+	}
+
+	m.debounced = true
+	m.collapsed = 0
+
+	return &m
+}
+
+func DebounceFirst(circuit Circuit, d time.Duration) Circuit {
 	var threshold time.Time
 	var result *Message
 	var err error
@@ -26,7 +45,7 @@ func (msg *Message) DebounceFirst(circuit Circuit, d time.Duration) Circuit {
 		}()
 
 		if time.Now().Before(threshold) {
-			return &Message{}, err
+			return debounced(result), err
 		}
 
 		result, err = circuit(ctx, msg)
@@ -34,3 +53,104 @@ func (msg *Message) DebounceFirst(circuit Circuit, d time.Duration) Circuit {
 		return result, err
 	}
 }
+
+type debounceResult struct {
+	msg *Message
+	err error
+}
+
+// detached keeps the values of a context, but not its deadline or
+// cancellation, like context.WithoutCancel in later versions of Go.
+type detached struct {
+	context.Context
+}
+
+func (detached) Deadline() (time.Time, bool) {
+
+	return time.Time{}, false
+}
+
+func (detached) Done() <-chan struct{} {
+
+	return nil
+}
+
+func (detached) Err() error {
+
+	return nil
+}
+
+func DebounceLast(circuit Circuit, d time.Duration) Circuit {
+	var timer *time.Timer
+	var latest *Message
+	var latestCtx context.Context
+	var waiters []chan debounceResult
+	var m sync.Mutex
+
+	// The call runs until the last of its callers' deadlines,
+	// or without one if any caller has none.
+	var deadline time.Time
+	var unbounded bool
+
+	fire := func() {
+		m.Lock()
+		ctx, msg, ws := latestCtx, latest, waiters
+		callDeadline, callUnbounded := deadline, unbounded
+		timer, waiters = nil, nil
+		deadline, unbounded = time.Time{}, false
+		m.Unlock()
+
+		// A call may have reset the timer just as it went off.
+		// The first run then already made the call for it.
+		if len(ws) == 0 {
+			return
+		}
+
+		ctx = detached{ctx}
+		if !callUnbounded {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithDeadline(ctx, callDeadline)
+			defer cancel()
+		}
+
+		result, err := circuit(ctx, msg)
+
+		for i, w := range ws {
+			if i < len(ws)-1 {
+				w <- debounceResult{debounced(result), err}
+				continue
+			}
+
+			if result != nil {
+				result.collapsed = uint32(len(ws) - 1)
+			}
+			w <- debounceResult{result, err}
+		}
+	}
+
+	return func(ctx context.Context, msg *Message) (*Message, error) {
+		done := make(chan debounceResult, 1)
+
+		m.Lock()
+		latest, latestCtx = msg, ctx
+		waiters = append(waiters, done)
+		if dl, ok := ctx.Deadline(); !ok {
+			unbounded = true
+		} else if dl.After(deadline) {
+			deadline = dl
+		}
+		if timer == nil {
+			timer = time.AfterFunc(d, fire)
+		} else {
+			timer.Reset(d)
+		}
+		m.Unlock()
+
+		select {
+		case r := <-done:
+			return r.msg, r.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
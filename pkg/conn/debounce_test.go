@@ -0,0 +1,102 @@
+package conn
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDebounceLast(t *testing.T) {
+
+	var mu sync.Mutex
+	var sent []string
+
+	circuit := func(ctx context.Context, msg *Message) (*Message, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, string(msg.data))
+		return &Message{data: msg.data}, nil
+	}
+
+	debounce := DebounceLast(circuit, 50*time.Millisecond)
+
+	var wg sync.WaitGroup
+	replies := make([]*Message, 3)
+	for i := range replies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			replies[i], _ = debounce(context.Background(), &Message{data: []byte("update")})
+		}(i)
+		time.Sleep(10 * time.Millisecond)
+	}
+	wg.Wait()
+
+	if len(sent) != 1 {
+		t.Fatalf("Expected burst to be sent once. Sent: %d\n", len(sent))
+	}
+
+	var debouncedCount int
+	var collapsed uint32
+	for _, r := range replies {
+		if r.debounced {
+			debouncedCount++
+		}
+		collapsed += r.collapsed
+	}
+
+	if debouncedCount != 2 || collapsed != 2 {
+		t.Errorf("Expected 2 debounced replies and 2 collapsed messages. Got: %d %d\n",
+			debouncedCount, collapsed)
+	}
+}
+
+func TestDebounceLastCancel(t *testing.T) {
+
+	circuit := func(ctx context.Context, msg *Message) (*Message, error) {
+		time.Sleep(20 * time.Millisecond)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return &Message{data: msg.data}, nil
+	}
+
+	debounce := DebounceLast(circuit, 20*time.Millisecond)
+
+	first := make(chan error, 1)
+	go func() {
+		_, err := debounce(context.Background(), &Message{data: []byte("update")})
+		first <- err
+	}()
+	time.Sleep(5 * time.Millisecond)
+
+	// The last caller gives up while the call is being made.
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(30*time.Millisecond, cancel)
+	if _, err := debounce(ctx, &Message{data: []byte("update")}); err != context.Canceled {
+		t.Errorf("Expected the last caller to see its own cancellation. Got: %v\n", err)
+	}
+
+	if err := <-first; err != nil {
+		t.Errorf("Expected the first caller not to be failed by the last one.\n\terr: %v\n", err)
+	}
+}
+
+func TestDebounceFirst(t *testing.T) {
+
+	calls := 0
+	circuit := func(ctx context.Context, msg *Message) (*Message, error) {
+		calls++
+		return &Message{}, nil
+	}
+
+	debounce := DebounceFirst(circuit, time.Minute)
+
+	first, _ := debounce(context.Background(), &Message{})
+	second, _ := debounce(context.Background(), &Message{})
+
+	if calls != 1 || first.debounced || !second.debounced {
+		t.Errorf("Expected only the first message to be sent. calls: %d\n", calls)
+	}
+}
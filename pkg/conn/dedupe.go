@@ -0,0 +1,119 @@
+package conn
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+)
+
+// JetStream's default duplicate window, so that Pub and PubJS
+// deduplicate alike unless configured otherwise.
+const (
+	defaultDedupeWindow = 2 * time.Minute
+)
+
+// Keys are scoped to a topic, like a JetStream stream scopes its message IDs.
+// They are not scoped to a client, so that a client retrying after it
+// registered again is still deduplicated.
+type dedupeKey struct {
+	topic string
+	key   string
+}
+
+// pubDedupe remembers the idempotency keys of recent core NATS publishes.
+type pubDedupe struct {
+	mu    sync.Mutex
+	seen  map[dedupeKey]time.Time
+	swept time.Time
+}
+
+func newPubDedupe() *pubDedupe {
+
+	return &pubDedupe{
+		seen: make(map[dedupeKey]time.Time),
+	}
+}
+
+func dedupeWindow() time.Duration {
+
+	window := viper.GetDuration("nats.dedupeWindow")
+	if window <= 0 {
+		window = defaultDedupeWindow
+	}
+
+	return window
+}
+
+// reserve returns false if the key was already published to the topic within
+// the dedupe window. Otherwise the key is held until the window ends, or until
+// it is released because the publish failed.
+func (d *pubDedupe) reserve(topic, key string) bool {
+
+	k := dedupeKey{topic, key}
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if now.Sub(d.swept) > debounceSweepPeriod {
+		for k, expires := range d.seen {
+			if now.After(expires) {
+				delete(d.seen, k)
+			}
+		}
+		d.swept = now
+	}
+
+	if expires, ok := d.seen[k]; ok && now.Before(expires) {
+		return false
+	}
+	d.seen[k] = now.Add(dedupeWindow())
+
+	return true
+}
+
+func (d *pubDedupe) release(topic, key string) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.seen, dedupeKey{topic, key})
+}
+
+// withMsgId sets the Nats-Msg-Id of the message, if there is a key.
+func withMsgId(m *nats.Msg, key string) *nats.Msg {
+
+	if key == "" {
+		return m
+	}
+
+	if m.Header == nil {
+		m.Header = nats.Header{}
+	}
+	m.Header.Set(nats.MsgIdHdr, key)
+
+	return m
+}
+
+// docsIdempotencyKey is the default idempotency key of uploaded documents.
+// It is a digest of the documents, so that a retried upload gets the same
+// key, and different documents never share one, whatever their docIds.
+func docsIdempotencyKey(docs *pb.Documents) string {
+
+	if len(docs.GetDoc()) == 0 {
+		return ""
+	}
+
+	bs, err := proto.MarshalOptions{Deterministic: true}.Marshal(docs)
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("docs-%x", sha256.Sum256(bs))
+}
@@ -0,0 +1,57 @@
+package conn
+
+import (
+	"testing"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+)
+
+func TestPubDedupe(t *testing.T) {
+
+	d := newPubDedupe()
+
+	if !d.reserve("topic", "key") {
+		t.Fatalf("Expected first publish with key to go through\n")
+	}
+	if d.reserve("topic", "key") {
+		t.Errorf("Expected second publish with key within the window to be a duplicate\n")
+	}
+	if !d.reserve("other", "key") {
+		t.Errorf("Expected the same key on another topic to go through\n")
+	}
+
+	// A failed publish can be retried with the same key.
+	d.release("topic", "key")
+	if !d.reserve("topic", "key") {
+		t.Errorf("Expected a released key to go through again\n")
+	}
+}
+
+func TestDocsIdempotencyKey(t *testing.T) {
+
+	docs := func(ids ...uint64) *pb.Documents {
+		ds := &pb.Documents{}
+		for _, id := range ids {
+			ds.Doc = append(ds.Doc, &pb.Doc{DocId: id, UserId: "user"})
+		}
+		return ds
+	}
+
+	if key := docsIdempotencyKey(&pb.Documents{}); key != "" {
+		t.Errorf("Expected no key without documents. Got: %s\n", key)
+	}
+
+	if docsIdempotencyKey(docs(1, 2, 3)) != docsIdempotencyKey(docs(1, 2, 3)) {
+		t.Errorf("Expected a retried chunk to get the same key\n")
+	}
+	if docsIdempotencyKey(docs(1, 2, 3)) == docsIdempotencyKey(docs(1, 2, 4)) {
+		t.Errorf("Expected different chunks to get different keys\n")
+	}
+
+	// Documents left without a docId are told apart by their contents.
+	other := docs(0)
+	other.Doc[0].UserId = "other"
+	if docsIdempotencyKey(docs(0)) == docsIdempotencyKey(other) {
+		t.Errorf("Expected different documents without a docId to get different keys\n")
+	}
+}
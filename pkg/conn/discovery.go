@@ -0,0 +1,278 @@
+package conn
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/find-in-docs/sidecar/pkg/utils"
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	discoveryAnnounceTopic = "sidecar.discovery.v1.announce"
+	discoveryQueryTopic    = "sidecar.discovery.v1.query"
+
+	defaultAnnouncePeriod = 30 * time.Second
+	defaultQueryTimeout   = 500 * time.Millisecond
+
+	// A sidecar not heard from for this many announce periods is gone.
+	peerAnnouncePeriods = 3
+)
+
+// peer is the last announcement heard from another sidecar.
+type peer struct {
+	announcement *pb.ServiceAnnouncement
+	heardAt      time.Time
+}
+
+// Discovery lets services behind one sidecar find services behind
+// every other sidecar connected to the same NATS cluster. Every sidecar
+// keeps the announcements of all others. When it starts, it asks them
+// to announce themselves, and waits for the query timeout before it
+// answers from what it heard.
+type Discovery struct {
+	natsConn *Conn
+	registry *Registry
+	addr     string
+	peerTTL  time.Duration
+	ready    chan struct{}
+
+	mu    sync.Mutex
+	peers map[string]*peer
+
+	announceSub *nats.Subscription
+	querySub    *nats.Subscription
+	replySub    *nats.Subscription
+}
+
+func InitDiscovery(ctx context.Context, natsConn *Conn, srv *Server) {
+
+	// The listen address may not be reachable from other hosts,
+	// so allow the address we hand out to be configured separately.
+	addr := viper.GetString("discovery.advertiseAddr")
+	if addr == "" {
+		addr = viper.GetString("sidecarServiceAddr")
+	}
+
+	announcePeriod := viper.GetDuration("discovery.announcePeriod")
+	if announcePeriod <= 0 {
+		announcePeriod = defaultAnnouncePeriod
+	}
+
+	queryTimeout := viper.GetDuration("discovery.queryTimeout")
+	if queryTimeout <= 0 {
+		queryTimeout = defaultQueryTimeout
+	}
+
+	d := &Discovery{
+		natsConn: natsConn,
+		registry: srv.Registry,
+		addr:     addr,
+		peerTTL:  peerAnnouncePeriods * announcePeriod,
+		ready:    make(chan struct{}),
+		peers:    make(map[string]*peer),
+	}
+
+	var err error
+	if d.announceSub, err = natsConn.Subscribe(discoveryAnnounceTopic, d.hear); err != nil {
+		fmt.Printf("Error subscribing to service announcements: %v\n", err)
+		os.Exit(-1)
+	}
+
+	if d.querySub, err = natsConn.Subscribe(discoveryQueryTopic, d.answerQuery); err != nil {
+		fmt.Printf("Error subscribing to discovery queries: %v\n", err)
+		os.Exit(-1)
+	}
+
+	// Sidecars that started before us announce themselves in reply.
+	inbox := nats.NewInbox()
+	if d.replySub, err = natsConn.Subscribe(inbox, d.hear); err != nil {
+		fmt.Printf("Error subscribing to discovery inbox: %v\n", err)
+		os.Exit(-1)
+	}
+
+	bs, err := proto.Marshal(&pb.DiscoveryQuery{})
+	if err != nil {
+		fmt.Printf("Error marshalling discovery query: %v\n", err)
+		os.Exit(-1)
+	}
+
+	if err = natsConn.nc.PublishRequest(discoveryQueryTopic, inbox, bs); err != nil {
+		fmt.Printf("Error publishing discovery query: %v\n", err)
+	}
+
+	srv.Discovery = d
+
+	goroutineName := "AnnounceServices"
+	err = utils.StartGoroutine(goroutineName,
+		func() {
+			queried := time.After(queryTimeout)
+
+		LOOP:
+			for {
+				select {
+				case <-queried:
+					close(d.ready)
+
+				case <-time.After(announcePeriod):
+					d.Announce()
+
+				case <-ctx.Done():
+					d.announceSub.Unsubscribe()
+					d.querySub.Unsubscribe()
+					d.replySub.Unsubscribe()
+
+					// Let the other sidecars forget our services now,
+					// instead of when our announcements run out.
+					d.publish(&pb.ServiceAnnouncement{
+						SidecarId:   serviceId()(),
+						SidecarAddr: d.addr,
+					})
+					break LOOP
+				}
+			}
+
+			fmt.Printf("GOROUTINE completed in function InitDiscovery\n")
+			utils.GoroutineEnded(goroutineName)
+		})
+
+	if err != nil {
+		fmt.Printf("Error starting goroutine: %v\n", err)
+		os.Exit(-1)
+	}
+}
+
+func (d *Discovery) announcement(serviceName string) *pb.ServiceAnnouncement {
+
+	sidecarId := serviceId()()
+
+	a := &pb.ServiceAnnouncement{
+		SidecarId:   sidecarId,
+		SidecarAddr: d.addr,
+	}
+
+	for _, c := range d.registry.Clients() {
+		if serviceName != "" && c.ServiceName != serviceName {
+			continue
+		}
+
+		a.Instances = append(a.Instances, &pb.ServiceInstance{
+			ServId:       c.ServId,
+			ServiceName:  c.ServiceName,
+			SidecarId:    sidecarId,
+			SidecarAddr:  d.addr,
+			RegisteredAt: timestamppb.New(c.RegisteredAt),
+			LastSeen:     timestamppb.New(c.LastSeen()),
+		})
+	}
+
+	return a
+}
+
+// Announce tells all other sidecars which services are registered here.
+func (d *Discovery) Announce() {
+
+	d.publish(d.announcement(""))
+}
+
+func (d *Discovery) publish(a *pb.ServiceAnnouncement) {
+
+	bs, err := proto.Marshal(a)
+	if err != nil {
+		fmt.Printf("Error marshalling service announcement: %v\n", err)
+		return
+	}
+
+	if err = d.natsConn.nc.Publish(discoveryAnnounceTopic, bs); err != nil {
+		fmt.Printf("Error publishing service announcement: %v\n", err)
+	}
+}
+
+// hear keeps the announcement of another sidecar. Each one lists all of
+// its services, so it replaces the one heard before.
+func (d *Discovery) hear(m *nats.Msg) {
+
+	a := &pb.ServiceAnnouncement{}
+	if err := proto.Unmarshal(m.Data, a); err != nil {
+		fmt.Printf("Error unmarshalling service announcement: %v\n", err)
+		return
+	}
+
+	// Our own services come straight from the registry.
+	if bytes.Equal(a.SidecarId, serviceId()()) {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.peers[string(a.SidecarId)] = &peer{
+		announcement: a,
+		heardAt:      time.Now(),
+	}
+}
+
+func (d *Discovery) answerQuery(m *nats.Msg) {
+
+	var q pb.DiscoveryQuery
+	if err := proto.Unmarshal(m.Data, &q); err != nil {
+		fmt.Printf("Error unmarshalling discovery query: %v\n", err)
+		return
+	}
+
+	a := d.announcement(q.ServiceName)
+	if len(a.Instances) == 0 {
+		return
+	}
+
+	bs, err := proto.Marshal(a)
+	if err != nil {
+		fmt.Printf("Error marshalling discovery reply: %v\n", err)
+		return
+	}
+
+	if err = m.Respond(bs); err != nil {
+		fmt.Printf("Error replying to discovery query: %v\n", err)
+	}
+}
+
+// Discover returns the instances of the given service registered here,
+// and those the other sidecars announced. Right after the sidecar starts,
+// it waits for the other sidecars to reply to its query first.
+func (d *Discovery) Discover(ctx context.Context, serviceName string) ([]*pb.ServiceInstance, error) {
+
+	select {
+	case <-d.ready:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("Error waiting for other sidecars to announce themselves: %w", ctx.Err())
+	}
+
+	instances := d.announcement(serviceName).Instances
+	now := time.Now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for sidecarId, p := range d.peers {
+		if now.Sub(p.heardAt) > d.peerTTL {
+			delete(d.peers, sidecarId)
+			continue
+		}
+
+		for _, i := range p.announcement.Instances {
+			if serviceName == "" || i.ServiceName == serviceName {
+				instances = append(instances, i)
+			}
+		}
+	}
+
+	return instances, nil
+}
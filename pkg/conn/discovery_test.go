@@ -0,0 +1,98 @@
+package conn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/find-in-docs/sidecar/pkg/log"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDiscoverFromAnnouncements(t *testing.T) {
+
+	registry := NewRegistry()
+	registry.Add([]byte("local"), "search", &pb.RegistrationParams{})
+
+	d := &Discovery{
+		registry: registry,
+		peerTTL:  time.Minute,
+		ready:    make(chan struct{}),
+		peers:    make(map[string]*peer),
+	}
+
+	// Until the other sidecars had time to reply, there is nothing to answer from.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err := d.Discover(ctx, "search"); err == nil {
+		t.Errorf("Expected Discover to wait for other sidecars\n")
+	}
+	close(d.ready)
+
+	hear := func(a *pb.ServiceAnnouncement) {
+		bs, err := proto.Marshal(a)
+		if err != nil {
+			t.Fatalf("Error marshalling announcement\n\terr: %v\n", err)
+		}
+		d.hear(&nats.Msg{Data: bs})
+	}
+
+	hear(&pb.ServiceAnnouncement{
+		SidecarId: []byte("peer"),
+		Instances: []*pb.ServiceInstance{
+			{ServId: []byte("remote"), ServiceName: "search"},
+			{ServId: []byte("other"), ServiceName: "index"},
+		},
+	})
+
+	// Our own announcements are ignored, or local services would show up twice.
+	hear(d.announcement(""))
+
+	instances, err := d.Discover(context.Background(), "search")
+	if err != nil {
+		t.Fatalf("Error discovering\n\terr: %v\n", err)
+	}
+	if len(instances) != 2 {
+		t.Errorf("Expected a local and a remote instance. Got: %v\n", instances)
+	}
+
+	// A newer announcement replaces the older one.
+	hear(&pb.ServiceAnnouncement{SidecarId: []byte("peer")})
+	if instances, _ = d.Discover(context.Background(), "search"); len(instances) != 1 {
+		t.Errorf("Expected only the local instance. Got: %v\n", instances)
+	}
+
+	// Sidecars that stopped announcing are forgotten.
+	hear(&pb.ServiceAnnouncement{
+		SidecarId: []byte("peer"),
+		Instances: []*pb.ServiceInstance{{ServId: []byte("remote"), ServiceName: "search"}},
+	})
+	d.peers["peer"].heardAt = time.Now().Add(-2 * time.Minute)
+	if instances, _ = d.Discover(context.Background(), "search"); len(instances) != 1 {
+		t.Errorf("Expected a stale sidecar to be forgotten. Got: %v\n", instances)
+	}
+	if _, ok := d.peers["peer"]; ok {
+		t.Errorf("Expected a stale sidecar to be removed\n")
+	}
+}
+
+func TestDiscoverBeforeDiscoveryStarts(t *testing.T) {
+
+	srv := &Server{
+		Registry: NewRegistry(),
+		Logs:     &Logs{logger: log.NewLogger(nil, &pb.Header{})},
+	}
+	srv.Registry.Add([]byte("client"), "search", &pb.RegistrationParams{})
+
+	_, err := srv.Discover(context.Background(), &pb.DiscoverMsg{
+		Header:      &pb.Header{ServId: []byte("client"), SrcServType: "search"},
+		ServiceName: "search",
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected Unavailable before discovery starts. Got: %v\n", err)
+	}
+}
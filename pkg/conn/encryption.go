@@ -0,0 +1,402 @@
+package conn
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+)
+
+const (
+	keyIdHeader = "Sidecar-Key-Id"
+
+	defaultKeyReloadPeriod = 10 * time.Second
+
+	defaultUndecryptableDelay      = 30 * time.Second
+	defaultUndecryptableDeliveries = 10
+)
+
+// errUnknownKey is returned for a message encrypted with a key we do not
+// have. The key may show up once the key files are reloaded.
+var errUnknownKey = errors.New("Error - message encrypted with unknown key")
+
+// errDecrypt is returned for a message that does not open with the key it
+// names. The key file may be caught half way through a rotation, so this
+// is not taken as proof that the message is bad.
+var errDecrypt = errors.New("Error - could not decrypt message")
+
+// Encryption keys in the sidecar-config look like:
+//
+//	encryption:
+//	  reloadPeriod: 10s
+//	  retryDelay: 30s
+//	  maxDeliveries: 10
+//	  topics:
+//	    - topic: "search.doc.>"
+//	      keyFile: "/mnt/keys/docs"
+//
+// The first matching entry wins, both to seal messages and to open them.
+// Messages to topics no entry matches are not encrypted.
+//
+// A key file has one key per line: a key ID, and a base64 AES key of 16,
+// 24 or 32 bytes. Blank lines and lines starting with # are skipped.
+// The last key encrypts. To rotate keys, add a new one at the end, and
+// keep the old ones for as long as messages encrypted with them are around.
+//
+// A JetStream message that does not open is delivered again after
+// retryDelay, in case the key shows up, and terminated once it was
+// delivered maxDeliveries times.
+type topicKeyFile struct {
+	Topic   string `mapstructure:"topic"`
+	KeyFile string `mapstructure:"keyFile"`
+}
+
+type topicKeys struct {
+	topicKeyFile
+
+	modTime time.Time
+	size    int64
+
+	currentId string
+	keys      map[string]cipher.AEAD
+}
+
+// Encryption seals message data with AES-GCM before it goes to NATS, and
+// opens it on the way back. The key ID goes in the Sidecar-Key-Id header,
+// and a random nonce goes in front of the sealed data.
+//
+// A nil *Encryption leaves messages alone.
+type Encryption struct {
+	reloadPeriod time.Duration
+
+	mu      sync.Mutex
+	topics  []*topicKeys
+	checked time.Time
+}
+
+func InitEncryption(srv *Server) error {
+
+	var files []topicKeyFile
+	if err := viper.UnmarshalKey("encryption.topics", &files); err != nil {
+		return fmt.Errorf("Error reading encryption.topics from config: %w", err)
+	}
+
+	e, err := newEncryption(files, viper.GetDuration("encryption.reloadPeriod"))
+	if err != nil {
+		return err
+	}
+
+	srv.Encryption = e
+	return nil
+}
+
+// newEncryption returns nil if there are no keys to encrypt with.
+func newEncryption(files []topicKeyFile, reloadPeriod time.Duration) (*Encryption, error) {
+
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	if reloadPeriod == 0 {
+		reloadPeriod = defaultKeyReloadPeriod
+	}
+
+	e := &Encryption{
+		reloadPeriod: reloadPeriod,
+		checked:      time.Now(),
+	}
+
+	for _, f := range files {
+		tk := &topicKeys{topicKeyFile: f}
+		if err := tk.load(); err != nil {
+			return nil, err
+		}
+		e.topics = append(e.topics, tk)
+	}
+
+	return e, nil
+}
+
+func (tk *topicKeys) load() error {
+
+	info, err := os.Stat(tk.KeyFile)
+	if err != nil {
+		return fmt.Errorf("Error reading key file: %s\n\terr: %w", tk.KeyFile, err)
+	}
+
+	f, err := os.Open(tk.KeyFile)
+	if err != nil {
+		return fmt.Errorf("Error reading key file: %s\n\terr: %w", tk.KeyFile, err)
+	}
+	defer f.Close()
+
+	keys := make(map[string]cipher.AEAD)
+	var currentId string
+
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return fmt.Errorf("Error in key file: %s line: %d - expected a key ID and a key\n",
+				tk.KeyFile, lineNum)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return fmt.Errorf("Error in key file: %s line: %d\n\terr: %w", tk.KeyFile, lineNum, err)
+		}
+
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return fmt.Errorf("Error in key file: %s line: %d\n\terr: %w", tk.KeyFile, lineNum, err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return fmt.Errorf("Error in key file: %s line: %d\n\terr: %w", tk.KeyFile, lineNum, err)
+		}
+
+		keys[fields[0]] = aead
+		currentId = fields[0]
+	}
+	if err = scanner.Err(); err != nil {
+		return fmt.Errorf("Error reading key file: %s\n\terr: %w", tk.KeyFile, err)
+	}
+
+	if currentId == "" {
+		return fmt.Errorf("Error - no keys in key file: %s\n", tk.KeyFile)
+	}
+
+	tk.modTime = info.ModTime()
+	tk.size = info.Size()
+	tk.currentId = currentId
+	tk.keys = keys
+
+	return nil
+}
+
+// reloadLocked reads the key files that changed since they were last read.
+// A file that cannot be read keeps its old keys, so that a half written
+// file does not stop messages from going through.
+func (e *Encryption) reloadLocked(now time.Time) {
+
+	e.checked = now
+
+	for _, tk := range e.topics {
+		info, err := os.Stat(tk.KeyFile)
+		if err != nil {
+			fmt.Printf("Error checking key file: %s\n\terr: %v\n", tk.KeyFile, err)
+			continue
+		}
+
+		if info.ModTime().Equal(tk.modTime) && info.Size() == tk.size {
+			continue
+		}
+
+		if err = tk.load(); err != nil {
+			fmt.Printf("Error reloading key file - keeping old keys\n\terr: %v\n", err)
+		}
+	}
+}
+
+// topicKeysLocked returns the keys of the first entry that matches subject,
+// or nil if the subject is not encrypted.
+func (e *Encryption) topicKeysLocked(subject string) *topicKeys {
+
+	for _, tk := range e.topics {
+		if subjectMatches(tk.Topic, subject) {
+			return tk
+		}
+	}
+
+	return nil
+}
+
+// withoutKeyId returns header without the key ID, copying it only if
+// it had one.
+func withoutKeyId(header nats.Header) nats.Header {
+
+	if _, ok := header[keyIdHeader]; !ok {
+		return header
+	}
+
+	stripped := make(nats.Header, len(header)-1)
+	for k, v := range header {
+		if k != keyIdHeader {
+			stripped[k] = v
+		}
+	}
+
+	return stripped
+}
+
+// seal encrypts data sent to subject, if there is a key for it. It returns
+// the data to send, and a copy of header with the key ID added. A key ID
+// the client set itself is dropped, so that receivers do not try to open
+// a message that was never sealed.
+func (e *Encryption) seal(subject string, data []byte, header nats.Header) ([]byte, nats.Header, error) {
+
+	header = withoutKeyId(header)
+
+	if e == nil {
+		return data, header, nil
+	}
+
+	now := time.Now()
+
+	e.mu.Lock()
+	if now.Sub(e.checked) > e.reloadPeriod {
+		e.reloadLocked(now)
+	}
+
+	var keyId string
+	var aead cipher.AEAD
+	if tk := e.topicKeysLocked(subject); tk != nil {
+		keyId = tk.currentId
+		aead = tk.keys[keyId]
+	}
+	e.mu.Unlock()
+
+	if aead == nil {
+		return data, header, nil
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("Error generating nonce\n\terr: %w", err)
+	}
+
+	sealed := make(nats.Header, len(header)+1)
+	for k, v := range header {
+		sealed[k] = v
+	}
+	sealed.Set(keyIdHeader, keyId)
+
+	return aead.Seal(nonce, nonce, data, []byte(keyId)), sealed, nil
+}
+
+// sealMsg encrypts the message in place.
+func (e *Encryption) sealMsg(m *nats.Msg) error {
+
+	data, header, err := e.seal(m.Subject, m.Data, m.Header)
+	if err != nil {
+		return err
+	}
+
+	m.Data = data
+	m.Header = header
+
+	return nil
+}
+
+// open decrypts the message, if it was encrypted. It returns the data,
+// and a copy of the headers without the key ID. The message itself is
+// left alone, since other subscribers may share it.
+func (e *Encryption) open(m *nats.Msg) ([]byte, nats.Header, error) {
+
+	return e.openFrom(m.Subject, m)
+}
+
+// openFrom is open, with the keys of subject instead of the subject the
+// message came in on. Replies come in on an inbox, but are sealed with
+// the keys of the subject the request went to.
+func (e *Encryption) openFrom(subject string, m *nats.Msg) ([]byte, nats.Header, error) {
+
+	keyId := m.Header.Get(keyIdHeader)
+	if keyId == "" {
+		return m.Data, m.Header, nil
+	}
+
+	aead, err := e.key(subject, keyId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonceSize := aead.NonceSize()
+	if len(m.Data) < nonceSize {
+		return nil, nil, fmt.Errorf("%w: sealed data too short on: %s", errBadMsg, m.Subject)
+	}
+
+	data, err := aead.Open(nil, m.Data[:nonceSize], m.Data[nonceSize:], []byte(keyId))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: on: %s with key: %s\n\terr: %v", errDecrypt, m.Subject, keyId, err)
+	}
+
+	var header nats.Header
+	if len(m.Header) > 1 {
+		header = withoutKeyId(m.Header)
+	}
+
+	return data, header, nil
+}
+
+// key finds the key with the given ID in the key file of subject. Key IDs
+// only have to be unique within a file. A message may have been encrypted
+// with a key added since we last looked, so an unknown key ID has the key
+// files checked again right away.
+func (e *Encryption) key(subject, keyId string) (cipher.AEAD, error) {
+
+	if e == nil {
+		return nil, fmt.Errorf("%w: %s - no encryption keys configured", errUnknownKey, keyId)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(e.checked) > e.reloadPeriod {
+		e.reloadLocked(now)
+	}
+
+	for reloaded := false; ; reloaded = true {
+		if tk := e.topicKeysLocked(subject); tk != nil {
+			if aead, ok := tk.keys[keyId]; ok {
+				return aead, nil
+			}
+		}
+
+		if reloaded {
+			return nil, fmt.Errorf("%w: %s", errUnknownKey, keyId)
+		}
+		e.reloadLocked(now)
+	}
+}
+
+// settleUndecryptable puts back a JetStream message that did not open,
+// to be delivered again once the key may have shown up. A message that
+// was delivered too often is terminated instead.
+func settleUndecryptable(m *nats.Msg) ackKind {
+
+	maxDeliveries := viper.GetUint64("encryption.maxDeliveries")
+	if maxDeliveries == 0 {
+		maxDeliveries = defaultUndecryptableDeliveries
+	}
+
+	if meta, err := m.Metadata(); err == nil && meta.NumDelivered >= maxDeliveries {
+		m.Term()
+		return ackTerm
+	}
+
+	delay := viper.GetDuration("encryption.retryDelay")
+	if delay <= 0 {
+		delay = defaultUndecryptableDelay
+	}
+
+	m.NakWithDelay(delay)
+	return ackNak
+}
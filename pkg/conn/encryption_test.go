@@ -0,0 +1,109 @@
+package conn
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+func appendTestKey(t *testing.T, keyFile, keyId string) {
+
+	key := make([]byte, 32)
+	rand.Read(key)
+
+	f, err := os.OpenFile(keyFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatalf("Error writing key file\n\terr: %v\n", err)
+	}
+	defer f.Close()
+
+	fmt.Fprintf(f, "%s %s\n", keyId, base64.StdEncoding.EncodeToString(key))
+}
+
+func TestEncryption(t *testing.T) {
+
+	keyFile := filepath.Join(t.TempDir(), "docs")
+	appendTestKey(t, keyFile, "k1")
+
+	// A negative reload period checks the key files on every message.
+	e, err := newEncryption([]topicKeyFile{{Topic: "test.secret.>", KeyFile: keyFile}}, -1)
+	if err != nil {
+		t.Fatalf("Error loading keys\n\terr: %v\n", err)
+	}
+
+	m := &nats.Msg{Subject: "test.secret.a", Data: []byte("hello"), Header: nats.Header{"Tenant": {"acme"}}}
+	if err = e.sealMsg(m); err != nil {
+		t.Fatalf("Error sealing message\n\terr: %v\n", err)
+	}
+	if string(m.Data) == "hello" || m.Header.Get(keyIdHeader) != "k1" || m.Header.Get("Tenant") != "acme" {
+		t.Fatalf("Expected message sealed with key k1. Got: %+v\n", m)
+	}
+
+	data, header, err := e.open(m)
+	if err != nil || string(data) != "hello" || header.Get(keyIdHeader) != "" || header.Get("Tenant") != "acme" {
+		t.Errorf("Expected message opened. Got: %s %v err: %v\n", data, header, err)
+	}
+
+	// Topics without a key stay in plaintext.
+	plain := &nats.Msg{Subject: "test.public", Data: []byte("hello")}
+	if err = e.sealMsg(plain); err != nil || string(plain.Data) != "hello" || plain.Header != nil {
+		t.Errorf("Expected message left alone. Got: %+v err: %v\n", plain, err)
+	}
+
+	// After rotation, new messages use the new key, and old ones still open.
+	appendTestKey(t, keyFile, "k2")
+
+	rotated := &nats.Msg{Subject: "test.secret.b", Data: []byte("again")}
+	if err = e.sealMsg(rotated); err != nil || rotated.Header.Get(keyIdHeader) != "k2" {
+		t.Errorf("Expected message sealed with key k2. Got: %+v err: %v\n", rotated, err)
+	}
+	if data, _, err = e.open(m); err != nil || string(data) != "hello" {
+		t.Errorf("Expected old message opened after rotation. Got: %s err: %v\n", data, err)
+	}
+
+	// Key IDs are looked up in the key file of the subject only.
+	otherFile := filepath.Join(t.TempDir(), "other")
+	appendTestKey(t, otherFile, "k1")
+	e.topics = append(e.topics, &topicKeys{topicKeyFile: topicKeyFile{Topic: "test.other", KeyFile: otherFile}})
+	e.reloadLocked(time.Now())
+
+	other := &nats.Msg{Subject: "test.other", Data: []byte("elsewhere")}
+	if err = e.sealMsg(other); err != nil {
+		t.Fatalf("Error sealing message\n\terr: %v\n", err)
+	}
+	if data, _, err = e.open(other); err != nil || string(data) != "elsewhere" {
+		t.Errorf("Expected message opened with its own key k1. Got: %s err: %v\n", data, err)
+	}
+	if data, _, err = e.open(m); err != nil || string(data) != "hello" {
+		t.Errorf("Expected message opened with its own key k1. Got: %s err: %v\n", data, err)
+	}
+
+	// Replies come in on an inbox.
+	reply := &nats.Msg{Subject: "_INBOX.abc", Data: other.Data, Header: other.Header}
+	if data, _, err = e.openFrom("test.other", reply); err != nil || string(data) != "elsewhere" {
+		t.Errorf("Expected reply opened with the key of the request topic. Got: %s err: %v\n", data, err)
+	}
+
+	// A key ID a client made up is not sent on.
+	forged := &nats.Msg{Subject: "test.public", Data: []byte("hello"), Header: nats.Header{keyIdHeader: {"k1"}}}
+	if err = e.sealMsg(forged); err != nil || forged.Header.Get(keyIdHeader) != "" {
+		t.Errorf("Expected client key ID dropped. Got: %+v err: %v\n", forged, err)
+	}
+
+	m.Data[len(m.Data)-1] ^= 1
+	if _, _, err = e.open(m); !errors.Is(err, errDecrypt) {
+		t.Errorf("Expected a tampered message to fail. Got: %v\n", err)
+	}
+
+	m.Header.Set(keyIdHeader, "k3")
+	if _, _, err = e.open(m); !errors.Is(err, errUnknownKey) {
+		t.Errorf("Expected an unknown key to fail. Got: %v\n", err)
+	}
+}
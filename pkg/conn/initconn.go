@@ -5,10 +5,12 @@ import (
 	"net"
 	"os"
 
+	"github.com/find-in-docs/sidecar/pkg/config"
 	"github.com/find-in-docs/sidecar/pkg/utils"
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func InitNATSconn() (*Conn, error) {
@@ -16,6 +18,7 @@ func InitNATSconn() (*Conn, error) {
 	c, err := NewNATSConn(viper.GetString("nats.url"))
 
 	if err != nil {
+		fmt.Printf("Error connecting to NATS\n\terr: %v\n", err)
 		os.Exit(-1)
 	}
 
@@ -26,7 +29,21 @@ func InitGRPCconn(srv *Server) {
 
 	goroutineName := "InitGRCPconn"
 	err := utils.StartGoroutine(goroutineName, func() {
-		s := grpc.NewServer()
+		tlsConfig, err := config.ServerTLS("tls.server")
+		if err != nil {
+			fmt.Printf("Error loading TLS config\n\terr: %v\n", err)
+			os.Exit(-1)
+		}
+
+		// Every call but Register has to come with the session it got.
+		opts := []grpc.ServerOption{
+			grpc.ChainUnaryInterceptor(srv.authUnary),
+			grpc.ChainStreamInterceptor(srv.authStream),
+		}
+		if tlsConfig != nil {
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		}
+		s := grpc.NewServer(opts...)
 
 		sidecarServiceAddr := viper.GetString("sidecarServiceAddr")
 
@@ -57,7 +74,16 @@ func InitGRPCconn(srv *Server) {
 func Initconns() (*Conn, *Server, error) {
 
 	// Initialize empty server. Load it with values you need later.
-	srv := &Server{}
+	sessions, err := NewSessions()
+	if err != nil {
+		fmt.Printf("Error initializing sessions:\n\terr: %v\n", err)
+		return nil, nil, err
+	}
+
+	srv := &Server{
+		Registry: NewRegistry(),
+		Sessions: sessions,
+	}
 
 	InitGRPCconn(srv)
 
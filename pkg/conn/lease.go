@@ -0,0 +1,104 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/find-in-docs/sidecar/pkg/utils"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	defaultLeaseCheckPeriod = time.Second
+
+	// Streams do not carry a Header, so clients send their
+	// assigned service ID in the stream metadata instead.
+	servIdMetadataKey = "servid"
+)
+
+// InitLeases starts a goroutine that deregisters clients whose lease expired.
+func InitLeases(ctx context.Context, srv *Server) {
+
+	period := viper.GetDuration("leaseCheckPeriod")
+	if period <= 0 {
+		period = defaultLeaseCheckPeriod
+	}
+
+	goroutineName := "ExpireLeases"
+	err := utils.StartGoroutine(goroutineName,
+		func() {
+		LOOP:
+			for {
+				select {
+				case now := <-time.After(period):
+					expired := srv.Registry.Expired(now)
+					for _, client := range expired {
+						srv.Logs.logger.Log("Lease expired for service: %s servId: %s\n",
+							client.ServiceName, string(client.ServId))
+						srv.releaseClient(client)
+					}
+
+					if len(expired) > 0 {
+						srv.announce()
+					}
+
+				case <-ctx.Done():
+					break LOOP
+				}
+			}
+
+			fmt.Printf("GOROUTINE completed in function InitLeases\n")
+			utils.GoroutineEnded(goroutineName)
+		})
+
+	if err != nil {
+		fmt.Printf("Error starting goroutine: %v\n", err)
+		os.Exit(-1)
+	}
+}
+
+// releaseClient tears down everything the sidecar holds for a client that
+// is no longer registered. Its context is already cancelled at this point,
+// which stops its streams and their flow-control goroutines.
+func (s *Server) releaseClient(client *RegisteredClient) {
+
+	s.Subs.releaseClient(client.ServId)
+	s.Pubs.releaseClient(client.ServId)
+	s.RateLimits.releaseClient(client.ServId)
+}
+
+// streamContext returns a context for a stream that is also cancelled when
+// the client that opened the stream goes away.
+func (s *Server) streamContext(ctx context.Context) (context.Context, context.CancelFunc) {
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, cancel
+	}
+
+	servIds := md.Get(servIdMetadataKey)
+	if len(servIds) == 0 {
+		return ctx, cancel
+	}
+
+	client, ok := s.Registry.Get([]byte(servIds[0]))
+	if !ok {
+		return ctx, cancel
+	}
+	client.Touch()
+
+	go func() {
+		select {
+		case <-client.Context().Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
@@ -12,6 +12,7 @@ import (
 
 const (
 	logChSize = 20
+	logTopic  = "search.log.v1"
 )
 
 type Logs struct {
@@ -57,7 +58,7 @@ func processLogMsg(logs *Logs, l *pb.LogMsg) {
 	header = l.GetHeader()
 	header.MsgId = NextMsgId()
 
-	err = logs.natsConn.Publish("search.log.v1", []byte(l.String()))
+	err = logs.natsConn.Publish(logTopic, []byte(l.String()))
 	if err != nil {
 		logs.logger.Log("Error publishing to NATS server: %v\n", err)
 	}
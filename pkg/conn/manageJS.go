@@ -0,0 +1,367 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func durationOrNil(d time.Duration) *durationpb.Duration {
+
+	if d == 0 {
+		return nil
+	}
+
+	return durationpb.New(d)
+}
+
+func natsStreamConfig(cfg *pb.StreamConfig) *nats.StreamConfig {
+
+	natsCfg := &nats.StreamConfig{
+		Name:       cfg.GetName(),
+		Subjects:   cfg.GetSubjects(),
+		Retention:  nats.LimitsPolicy,
+		Storage:    nats.FileStorage,
+		MaxAge:     cfg.GetMaxAge().AsDuration(),
+		MaxBytes:   cfg.GetMaxBytes(),
+		MaxMsgs:    cfg.GetMaxMsgs(),
+		Replicas:   int(cfg.GetReplicas()),
+		Duplicates: cfg.GetDuplicateWindow().AsDuration(),
+	}
+
+	// NATS takes -1 for unlimited.
+	if natsCfg.MaxBytes == 0 {
+		natsCfg.MaxBytes = -1
+	}
+	if natsCfg.MaxMsgs == 0 {
+		natsCfg.MaxMsgs = -1
+	}
+
+	switch cfg.GetRetention() {
+	case pb.RetentionPolicy_RETENTION_INTEREST:
+		natsCfg.Retention = nats.InterestPolicy
+	case pb.RetentionPolicy_RETENTION_WORK_QUEUE:
+		natsCfg.Retention = nats.WorkQueuePolicy
+	}
+
+	if cfg.GetStorage() == pb.StorageType_STORAGE_MEMORY {
+		natsCfg.Storage = nats.MemoryStorage
+	}
+
+	return natsCfg
+}
+
+func pbStreamInfo(info *nats.StreamInfo) *pb.StreamInfo {
+
+	cfg := &pb.StreamConfig{
+		Name:            info.Config.Name,
+		Subjects:        info.Config.Subjects,
+		MaxAge:          durationOrNil(info.Config.MaxAge),
+		MaxBytes:        info.Config.MaxBytes,
+		MaxMsgs:         info.Config.MaxMsgs,
+		Replicas:        int32(info.Config.Replicas),
+		DuplicateWindow: durationOrNil(info.Config.Duplicates),
+	}
+
+	switch info.Config.Retention {
+	case nats.InterestPolicy:
+		cfg.Retention = pb.RetentionPolicy_RETENTION_INTEREST
+	case nats.WorkQueuePolicy:
+		cfg.Retention = pb.RetentionPolicy_RETENTION_WORK_QUEUE
+	}
+
+	if info.Config.Storage == nats.MemoryStorage {
+		cfg.Storage = pb.StorageType_STORAGE_MEMORY
+	}
+
+	return &pb.StreamInfo{
+		Config:    cfg,
+		Created:   timestamppb.New(info.Created),
+		Msgs:      info.State.Msgs,
+		Bytes:     info.State.Bytes,
+		FirstSeq:  info.State.FirstSeq,
+		LastSeq:   info.State.LastSeq,
+		Consumers: uint32(info.State.Consumers),
+	}
+}
+
+func natsConsumerConfig(cfg *pb.ConsumerConfig) *nats.ConsumerConfig {
+
+	natsCfg := &nats.ConsumerConfig{
+		Durable:       cfg.GetDurableName(),
+		FilterSubject: cfg.GetFilterSubject(),
+		AckPolicy:     nats.AckExplicitPolicy,
+		AckWait:       cfg.GetAckWait().AsDuration(),
+		MaxDeliver:    int(cfg.GetMaxDeliver()),
+		MaxAckPending: int(cfg.GetMaxAckPending()),
+	}
+
+	switch cfg.GetAckPolicy() {
+	case pb.AckPolicy_ACK_POLICY_NONE:
+		natsCfg.AckPolicy = nats.AckNonePolicy
+	case pb.AckPolicy_ACK_POLICY_ALL:
+		natsCfg.AckPolicy = nats.AckAllPolicy
+	}
+
+	return natsCfg
+}
+
+func pbConsumerInfo(info *nats.ConsumerInfo) *pb.ConsumerInfo {
+
+	cfg := &pb.ConsumerConfig{
+		DurableName:   info.Config.Durable,
+		FilterSubject: info.Config.FilterSubject,
+		AckWait:       durationOrNil(info.Config.AckWait),
+		MaxDeliver:    int32(info.Config.MaxDeliver),
+		MaxAckPending: int32(info.Config.MaxAckPending),
+	}
+
+	switch info.Config.AckPolicy {
+	case nats.AckNonePolicy:
+		cfg.AckPolicy = pb.AckPolicy_ACK_POLICY_NONE
+	case nats.AckAllPolicy:
+		cfg.AckPolicy = pb.AckPolicy_ACK_POLICY_ALL
+	}
+
+	return &pb.ConsumerInfo{
+		Stream:         info.Stream,
+		Config:         cfg,
+		Created:        timestamppb.New(info.Created),
+		NumPending:     info.NumPending,
+		NumAckPending:  uint64(info.NumAckPending),
+		NumRedelivered: uint64(info.NumRedelivered),
+		NumWaiting:     uint64(info.NumWaiting),
+	}
+}
+
+type streamOp int
+
+const (
+	streamAdd streamOp = iota
+	streamUpdate
+	streamDelete
+	streamInfo
+)
+
+func (op streamOp) String() string {
+
+	return [...]string{"add", "update", "delete", "get info for"}[op]
+}
+
+// ManageStream runs op on a stream. Only streamAdd and streamUpdate use cfg,
+// and the info returned is nil for streamDelete.
+func (c *Conn) ManageStream(op streamOp, name string, cfg *pb.StreamConfig) (*pb.StreamInfo, error) {
+
+	js, err := c.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var info *nats.StreamInfo
+	switch op {
+	case streamAdd:
+		info, err = js.AddStream(natsStreamConfig(cfg))
+	case streamUpdate:
+		info, err = js.UpdateStream(natsStreamConfig(cfg))
+	case streamDelete:
+		err = js.DeleteStream(name)
+	case streamInfo:
+		info, err = js.StreamInfo(name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error - could not %s stream: %s\n\terr: %w", op, name, err)
+	}
+
+	if info == nil {
+		return nil, nil
+	}
+
+	return pbStreamInfo(info), nil
+}
+
+// ManageConsumer runs op on a durable consumer. Only streamAdd and
+// streamUpdate use cfg, and the info returned is nil for streamDelete.
+func (c *Conn) ManageConsumer(op streamOp, stream, durableName string,
+	cfg *pb.ConsumerConfig) (*pb.ConsumerInfo, error) {
+
+	js, err := c.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	var info *nats.ConsumerInfo
+	switch op {
+	case streamAdd:
+		info, err = js.AddConsumer(stream, natsConsumerConfig(cfg))
+	case streamUpdate:
+		info, err = js.UpdateConsumer(stream, natsConsumerConfig(cfg))
+	case streamDelete:
+		err = js.DeleteConsumer(stream, durableName)
+	case streamInfo:
+		info, err = js.ConsumerInfo(stream, durableName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error - could not %s consumer: %s on stream: %s\n\terr: %w",
+			op, durableName, stream, err)
+	}
+
+	if info == nil {
+		return nil, nil
+	}
+
+	return pbConsumerInfo(info), nil
+}
+
+func (s *Server) AddStream(ctx context.Context, in *pb.StreamMsg) (*pb.StreamMsgResponse, error) {
+
+	return s.manageStream(in.Header, streamAdd, in.GetConfig().GetName(), in.GetConfig())
+}
+
+func (s *Server) UpdateStream(ctx context.Context, in *pb.StreamMsg) (*pb.StreamMsgResponse, error) {
+
+	return s.manageStream(in.Header, streamUpdate, in.GetConfig().GetName(), in.GetConfig())
+}
+
+func (s *Server) DeleteStream(ctx context.Context, in *pb.StreamNameMsg) (*pb.StreamMsgResponse, error) {
+
+	return s.manageStream(in.Header, streamDelete, in.GetName(), nil)
+}
+
+func (s *Server) GetStreamInfo(ctx context.Context, in *pb.StreamNameMsg) (*pb.StreamMsgResponse, error) {
+
+	return s.manageStream(in.Header, streamInfo, in.GetName(), nil)
+}
+
+func (s *Server) manageStream(header *pb.Header, op streamOp, name string,
+	cfg *pb.StreamConfig) (*pb.StreamMsgResponse, error) {
+
+	header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received request to %s stream: %s config: %s\n", op, name, cfg)
+
+	client, err := s.Registry.Lookup(header)
+	if err != nil {
+		s.Logs.logger.Log("Error managing stream: %s\n", err.Error())
+		return nil, err
+	}
+
+	owners := s.Subs.owners
+	if op == streamUpdate || op == streamDelete {
+		if err = owners.canModifyStream(client.ServiceName, op, name); err != nil {
+			s.Logs.logger.Log("Error managing stream: %s\n", err.Error())
+			return nil, err
+		}
+	}
+
+	info, err := s.Subs.natsConn.ManageStream(op, name, cfg)
+	if err != nil {
+		s.Logs.logger.Log("Error managing stream: %s\n", err.Error())
+		return nil, err
+	}
+
+	switch op {
+	case streamAdd:
+		owners.addedStream(client.ServiceName, name)
+	case streamDelete:
+		owners.deletedStream(name)
+	}
+
+	streamMsgRsp := &pb.StreamMsgResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_STREAM_RSP,
+			SrcServType: serviceType(),
+			DstServType: header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg:  "OK",
+		Info: info,
+	}
+	s.Logs.logger.Log("Sending StreamMsgResponse: %s\n", streamMsgRsp)
+
+	return streamMsgRsp, nil
+}
+
+func (s *Server) AddConsumer(ctx context.Context, in *pb.ConsumerMsg) (*pb.ConsumerMsgResponse, error) {
+
+	return s.manageConsumer(in.Header, streamAdd, in.GetStream(), in.GetConfig().GetDurableName(), in.GetConfig())
+}
+
+func (s *Server) UpdateConsumer(ctx context.Context, in *pb.ConsumerMsg) (*pb.ConsumerMsgResponse, error) {
+
+	return s.manageConsumer(in.Header, streamUpdate, in.GetStream(), in.GetConfig().GetDurableName(), in.GetConfig())
+}
+
+func (s *Server) DeleteConsumer(ctx context.Context, in *pb.ConsumerNameMsg) (*pb.ConsumerMsgResponse, error) {
+
+	return s.manageConsumer(in.Header, streamDelete, in.GetStream(), in.GetDurableName(), nil)
+}
+
+func (s *Server) GetConsumerInfo(ctx context.Context, in *pb.ConsumerNameMsg) (*pb.ConsumerMsgResponse, error) {
+
+	return s.manageConsumer(in.Header, streamInfo, in.GetStream(), in.GetDurableName(), nil)
+}
+
+func (s *Server) manageConsumer(header *pb.Header, op streamOp, stream, durableName string,
+	cfg *pb.ConsumerConfig) (*pb.ConsumerMsgResponse, error) {
+
+	header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received request to %s consumer: %s stream: %s config: %s\n",
+		op, durableName, stream, cfg)
+
+	client, err := s.Registry.Lookup(header)
+	if err != nil {
+		s.Logs.logger.Log("Error managing consumer: %s\n", err.Error())
+		return nil, err
+	}
+
+	owners := s.Subs.owners
+	if op == streamUpdate || op == streamDelete {
+		if err = owners.canModifyConsumer(client.ServiceName, op, stream, durableName); err != nil {
+			s.Logs.logger.Log("Error managing consumer: %s\n", err.Error())
+			return nil, err
+		}
+	}
+
+	info, err := s.Subs.natsConn.ManageConsumer(op, stream, durableName, cfg)
+	if err != nil {
+		s.Logs.logger.Log("Error managing consumer: %s\n", err.Error())
+		return nil, err
+	}
+
+	switch op {
+	case streamAdd:
+		owners.addedConsumer(client.ServiceName, stream, durableName)
+	case streamDelete:
+		owners.deletedConsumer(stream, durableName)
+	}
+
+	consumerMsgRsp := &pb.ConsumerMsgResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_CONSUMER_RSP,
+			SrcServType: serviceType(),
+			DstServType: header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg:  "OK",
+		Info: info,
+	}
+	s.Logs.logger.Log("Sending ConsumerMsgResponse: %s\n", consumerMsgRsp)
+
+	return consumerMsgRsp, nil
+}
@@ -0,0 +1,106 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestStreamConfigRoundTrip(t *testing.T) {
+
+	cfg := &pb.StreamConfig{
+		Name:            "testStream",
+		Subjects:        []string{"test.stream.>"},
+		Retention:       pb.RetentionPolicy_RETENTION_WORK_QUEUE,
+		Storage:         pb.StorageType_STORAGE_MEMORY,
+		MaxAge:          durationpb.New(time.Hour),
+		MaxBytes:        1 << 20,
+		MaxMsgs:         100,
+		Replicas:        1,
+		DuplicateWindow: durationpb.New(time.Minute),
+	}
+
+	info := pbStreamInfo(&nats.StreamInfo{Config: *natsStreamConfig(cfg)})
+	if !proto.Equal(info.Config, cfg) {
+		t.Errorf("Expected stream config: %s\nGot: %s\n", cfg, info.Config)
+	}
+
+	// Zero limits are unlimited.
+	natsCfg := natsStreamConfig(&pb.StreamConfig{Name: "testStream"})
+	if natsCfg.MaxBytes != -1 || natsCfg.MaxMsgs != -1 || natsCfg.Retention != nats.LimitsPolicy {
+		t.Errorf("Expected unlimited stream with limits retention. Got: %+v\n", natsCfg)
+	}
+}
+
+func TestConsumerConfigRoundTrip(t *testing.T) {
+
+	cfg := &pb.ConsumerConfig{
+		DurableName:   "testConsumer",
+		FilterSubject: "test.stream.docs",
+		AckPolicy:     pb.AckPolicy_ACK_POLICY_ALL,
+		AckWait:       durationpb.New(30 * time.Second),
+		MaxDeliver:    5,
+		MaxAckPending: 50,
+	}
+
+	info := pbConsumerInfo(&nats.ConsumerInfo{Config: *natsConsumerConfig(cfg)})
+	if !proto.Equal(info.Config, cfg) {
+		t.Errorf("Expected consumer config: %s\nGot: %s\n", cfg, info.Config)
+	}
+}
+
+func TestDeleteOwnership(t *testing.T) {
+
+	defer setConfig(map[string]interface{}{
+		"nats.jetstream.name":                 "docs",
+		"nats.jetstream.consumer.durableName": "docsConsumer",
+		"nats.jetstream.modifiable.streams":   []string{"scratch-*"},
+		"nats.jetstream.modifiable.consumers": []string{},
+	})()
+
+	owners := newJSOwners()
+	owners.addedStream("owner", "orders")
+	owners.addedStream("other", "orders")
+	owners.addedConsumer("owner", "orders", "worker")
+
+	if err := owners.canModifyStream("owner", streamDelete, "orders"); err != nil {
+		t.Errorf("Expected the owner to delete its stream.\n\terr: %v\n", err)
+	}
+	if err := owners.canModifyStream("owner", streamUpdate, "orders"); err != nil {
+		t.Errorf("Expected the owner to update its stream.\n\terr: %v\n", err)
+	}
+	if err := owners.canModifyStream("other", streamDelete, "orders"); err == nil {
+		t.Errorf("Expected another service not to delete the stream\n")
+	}
+	if err := owners.canModifyStream("other", streamUpdate, "orders"); err == nil {
+		t.Errorf("Expected another service not to update the stream\n")
+	}
+	if err := owners.canModifyConsumer("other", streamDelete, "orders", "worker"); err == nil {
+		t.Errorf("Expected another service not to delete the consumer\n")
+	}
+	if err := owners.canModifyConsumer("other", streamUpdate, "orders", "worker"); err == nil {
+		t.Errorf("Expected another service not to update the consumer\n")
+	}
+	if err := owners.canModifyStream("other", streamDelete, "scratch-1"); err != nil {
+		t.Errorf("Expected an allow-listed stream to be deletable.\n\terr: %v\n", err)
+	}
+
+	owners.addedStream("owner", "docs")
+	for _, op := range []streamOp{streamUpdate, streamDelete} {
+		if err := owners.canModifyStream("owner", op, "docs"); err == nil {
+			t.Errorf("Expected clients not to %s the sidecar's stream\n", op)
+		}
+		if err := owners.canModifyConsumer("owner", op, "docs", "docsConsumer"); err == nil {
+			t.Errorf("Expected clients not to %s the sidecar's consumer\n", op)
+		}
+	}
+
+	owners.deletedStream("orders")
+	if err := owners.canModifyConsumer("owner", streamDelete, "orders", "worker"); err == nil {
+		t.Errorf("Expected consumers to be forgotten with their stream\n")
+	}
+}
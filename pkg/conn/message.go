@@ -1,5 +1,10 @@
 package conn
 
+import (
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+)
+
 type ServiceTyp uint32
 type ServiceIdTyp uint64
 type RequestIdTyp uint64
@@ -13,6 +18,13 @@ type Header struct {
 }
 
 type Message struct {
-	header Header
-	data   []byte
+	header  Header
+	topic   string
+	data    []byte
+	headers nats.Header
+	retry   *pb.RetryBehavior
+
+	// Set on replies to calls that were debounced.
+	debounced bool
+	collapsed uint32
 }
@@ -0,0 +1,336 @@
+package conn
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultSpillMaxBytes = 64 << 20
+
+	spillRecordLenSize = 4
+)
+
+// send hands a message from NATS to the subscriber, following its overflow
+// policy once its buffer is full. Only OVERFLOW_BLOCK holds up the NATS
+// callback, and with it every other subscriber of the topic.
+func (sub *subscription) send(m *nats.Msg) {
+
+	switch sub.overflow {
+
+	case pb.OverflowPolicy_OVERFLOW_DROP_NEWEST:
+		select {
+		case sub.msgs <- m:
+		default:
+			sub.dropped.Add(1)
+		}
+
+	case pb.OverflowPolicy_OVERFLOW_DROP_OLDEST:
+		for {
+			select {
+			case sub.msgs <- m:
+				return
+			default:
+			}
+
+			// The subscriber may have taken a message in the meantime,
+			// in which case there is nothing to drop.
+			select {
+			case <-sub.msgs:
+				sub.dropped.Add(1)
+			default:
+			}
+		}
+
+	case pb.OverflowPolicy_OVERFLOW_SPILL:
+		// Messages already in the file go first, to keep them in order.
+		if sub.spill.empty() {
+			select {
+			case sub.msgs <- m:
+				return
+			default:
+			}
+		}
+
+		ok, err := sub.spill.push(m)
+		if err != nil {
+			fmt.Printf("Error spilling message to disk:\n\ttopic: %s\n\terr: %v\n", sub.topic, err)
+		}
+		if !ok {
+			sub.dropped.Add(1)
+		}
+
+	default:
+		select {
+		case sub.msgs <- m:
+		case <-sub.done:
+		}
+	}
+}
+
+// drainSpill moves spilled messages back to the subscriber, as it makes
+// room for them. It runs until the subscription ends.
+func (sub *subscription) drainSpill() {
+
+	defer sub.spill.close()
+
+	for {
+		select {
+		case <-sub.spill.wake:
+		case <-sub.done:
+			return
+		}
+
+		for {
+			m, err := sub.spill.pop()
+			if err != nil {
+				fmt.Printf("Error reading spilled messages:\n\ttopic: %s\n\terr: %v\n", sub.topic, err)
+				sub.dropped.Add(sub.spill.reset())
+				break
+			}
+			if m == nil {
+				break
+			}
+
+			select {
+			case sub.msgs <- m:
+				sub.spill.delivered()
+			case <-sub.done:
+				return
+			}
+		}
+	}
+}
+
+// spillBuffer keeps messages in a file, oldest first. The file is only
+// emptied once every message in it was delivered, so maxBytes limits
+// the size of the file, not the number of messages waiting.
+type spillBuffer struct {
+	maxBytes int64
+	wake     chan struct{}
+
+	mu       sync.Mutex
+	file     *os.File
+	readOff  int64
+	writeOff int64
+	closed   bool
+
+	// Messages in the file, plus the one being handed to the subscriber.
+	pending uint64
+}
+
+func newSpillBuffer(maxBytes uint64) *spillBuffer {
+
+	if maxBytes == 0 {
+		maxBytes = viper.GetUint64("subscriptions.spillMaxBytes")
+	}
+	if maxBytes == 0 {
+		maxBytes = defaultSpillMaxBytes
+	}
+
+	return &spillBuffer{
+		maxBytes: int64(maxBytes),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+func (b *spillBuffer) empty() bool {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.pending == 0
+}
+
+// push adds a message to the end of the file.
+// It returns false if the message did not fit.
+func (b *spillBuffer) push(m *nats.Msg) (bool, error) {
+
+	record := encodeSpilled(m)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return true, nil
+	}
+
+	if b.writeOff+int64(len(record)) > b.maxBytes {
+		return false, nil
+	}
+
+	if b.file == nil {
+		// The file is removed right away. It goes away with
+		// the sidecar, even if the sidecar does not exit cleanly.
+		file, err := os.CreateTemp(viper.GetString("subscriptions.spillDir"), "sidecar-spill-")
+		if err != nil {
+			return false, err
+		}
+		os.Remove(file.Name())
+		b.file = file
+	}
+
+	if _, err := b.file.WriteAt(record, b.writeOff); err != nil {
+		return false, err
+	}
+	b.writeOff += int64(len(record))
+	b.pending++
+
+	select {
+	case b.wake <- struct{}{}:
+	default:
+	}
+
+	return true, nil
+}
+
+// pop returns the oldest message in the file, or nil if there is none.
+func (b *spillBuffer) pop() (*nats.Msg, error) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.file == nil || b.readOff >= b.writeOff {
+		return nil, nil
+	}
+
+	var lenBuf [spillRecordLenSize]byte
+	if _, err := b.file.ReadAt(lenBuf[:], b.readOff); err != nil {
+		return nil, err
+	}
+
+	record := make([]byte, spillRecordLenSize+int(binary.BigEndian.Uint32(lenBuf[:])))
+	if _, err := b.file.ReadAt(record, b.readOff); err != nil {
+		return nil, err
+	}
+	b.readOff += int64(len(record))
+
+	return decodeSpilled(record[spillRecordLenSize:])
+}
+
+// delivered is called once a popped message reached the subscriber.
+func (b *spillBuffer) delivered() {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending--
+	if b.pending == 0 {
+		b.truncateLocked()
+	}
+}
+
+// reset throws away everything in the file, and returns how many messages
+// were lost.
+func (b *spillBuffer) reset() uint64 {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	lost := b.pending
+	b.pending = 0
+	b.truncateLocked()
+
+	return lost
+}
+
+func (b *spillBuffer) truncateLocked() {
+
+	b.readOff, b.writeOff = 0, 0
+	if b.file != nil {
+		b.file.Truncate(0)
+	}
+}
+
+func (b *spillBuffer) close() {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	if b.file != nil {
+		b.file.Close()
+		b.file = nil
+	}
+}
+
+// A spilled message is its length, followed by the subject, reply, data
+// and headers, each prefixed with its own length. The headers are their
+// keys and values, each prefixed with its own length too.
+func encodeSpilled(m *nats.Msg) []byte {
+
+	var header []byte
+	for k, values := range m.Header {
+		for _, v := range values {
+			header = appendSpilledField(header, []byte(k))
+			header = appendSpilledField(header, []byte(v))
+		}
+	}
+
+	record := make([]byte, spillRecordLenSize)
+	for _, field := range [][]byte{[]byte(m.Subject), []byte(m.Reply), m.Data, header} {
+		record = appendSpilledField(record, field)
+	}
+
+	binary.BigEndian.PutUint32(record, uint32(len(record)-spillRecordLenSize))
+
+	return record
+}
+
+func appendSpilledField(record, field []byte) []byte {
+
+	record = binary.AppendUvarint(record, uint64(len(field)))
+	return append(record, field...)
+}
+
+// nextSpilledField returns the field at the start of record, and the rest of it.
+func nextSpilledField(record []byte) ([]byte, []byte, error) {
+
+	n, size := binary.Uvarint(record)
+	if size <= 0 || uint64(len(record)-size) < n {
+		return nil, nil, errors.New("corrupt spill record")
+	}
+
+	return record[size : size+int(n)], record[size+int(n):], nil
+}
+
+func decodeSpilled(record []byte) (*nats.Msg, error) {
+
+	var fields [4][]byte
+	var err error
+	for i := range fields {
+		if fields[i], record, err = nextSpilledField(record); err != nil {
+			return nil, err
+		}
+	}
+
+	m := &nats.Msg{
+		Subject: string(fields[0]),
+		Reply:   string(fields[1]),
+		Data:    fields[2],
+	}
+
+	for header := fields[3]; len(header) > 0; {
+		var k, v []byte
+		if k, header, err = nextSpilledField(header); err != nil {
+			return nil, err
+		}
+		if v, header, err = nextSpilledField(header); err != nil {
+			return nil, err
+		}
+
+		if m.Header == nil {
+			m.Header = nats.Header{}
+		}
+		m.Header[string(k)] = append(m.Header[string(k)], string(v))
+	}
+
+	return m, nil
+}
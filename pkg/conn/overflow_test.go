@@ -0,0 +1,108 @@
+package conn
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+)
+
+func newTestSubscription(overflow pb.OverflowPolicy, chanSize int) *subscription {
+
+	return &subscription{
+		topic:    "test.overflow",
+		msgs:     make(chan *nats.Msg, chanSize),
+		done:     make(chan struct{}),
+		overflow: overflow,
+	}
+}
+
+func sendTestMsgs(sub *subscription, n int) {
+
+	for i := 0; i < n; i++ {
+		sub.send(&nats.Msg{Subject: sub.topic, Data: []byte(fmt.Sprint(i))})
+	}
+}
+
+func TestOverflowDrop(t *testing.T) {
+
+	newest := newTestSubscription(pb.OverflowPolicy_OVERFLOW_DROP_NEWEST, 2)
+	sendTestMsgs(newest, 5)
+
+	if got := string((<-newest.msgs).Data); got != "0" || newest.dropped.Load() != 3 {
+		t.Errorf("Drop newest: expected to keep message 0 and drop 3. Got: %s dropped: %d\n",
+			got, newest.dropped.Load())
+	}
+
+	oldest := newTestSubscription(pb.OverflowPolicy_OVERFLOW_DROP_OLDEST, 2)
+	sendTestMsgs(oldest, 5)
+
+	if got := string((<-oldest.msgs).Data); got != "3" || oldest.dropped.Load() != 3 {
+		t.Errorf("Drop oldest: expected to keep message 3 and drop 3. Got: %s dropped: %d\n",
+			got, oldest.dropped.Load())
+	}
+}
+
+func TestOverflowSpill(t *testing.T) {
+
+	sub := newTestSubscription(pb.OverflowPolicy_OVERFLOW_SPILL, 1)
+
+	// Room for 10 spilled messages.
+	sub.spill = newSpillBuffer(uint64(10 * len(encodeSpilled(&nats.Msg{Subject: sub.topic, Data: []byte("0")}))))
+	go sub.drainSpill()
+	defer close(sub.done)
+
+	sendTestMsgs(sub, 5)
+
+	// Messages come back in order, from memory and then from the file.
+	for i := 0; i < 5; i++ {
+		select {
+		case m := <-sub.msgs:
+			if string(m.Data) != fmt.Sprint(i) {
+				t.Errorf("Expected message %d. Got: %s\n", i, m.Data)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timed out waiting for message %d\n", i)
+		}
+	}
+
+	if sub.dropped.Load() != 0 {
+		t.Errorf("Expected no drops. Got: %d\n", sub.dropped.Load())
+	}
+
+	// Nobody reads now, so the file fills up.
+	sendTestMsgs(sub, 20)
+	time.Sleep(50 * time.Millisecond)
+
+	if sub.dropped.Load() == 0 {
+		t.Errorf("Expected drops once the spill file is full\n")
+	}
+}
+
+func TestSpillRecord(t *testing.T) {
+
+	m := &nats.Msg{
+		Subject: "test.spill",
+		Reply:   "_INBOX.reply",
+		Data:    []byte("data"),
+		Header:  nats.Header{"Tenant": {"acme"}, "Correlation-Id": {"a", "b"}},
+	}
+
+	record := encodeSpilled(m)
+	got, err := decodeSpilled(record[spillRecordLenSize:])
+	if err != nil {
+		t.Fatalf("Error decoding spill record\n\terr: %v\n", err)
+	}
+
+	if got.Subject != m.Subject || got.Reply != m.Reply || string(got.Data) != string(m.Data) ||
+		!reflect.DeepEqual(got.Header, m.Header) {
+		t.Errorf("Expected: %+v\nGot: %+v\n", m, got)
+	}
+
+	if _, err = decodeSpilled(record[spillRecordLenSize : len(record)-1]); err == nil {
+		t.Errorf("Expected an error decoding a truncated spill record\n")
+	}
+}
@@ -0,0 +1,136 @@
+package conn
+
+import (
+	"fmt"
+	"path"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+// jsOwners remembers which service added each stream and consumer through
+// this sidecar. Only that service may update or delete them. Streams and
+// consumers added some other way, or before the sidecar started, can only
+// be updated or deleted if the sidecar-config lets anyone do so:
+//
+//	nats:
+//	  jetstream:
+//	    modifiable:
+//	      streams: ["scratch-*"]
+//	      consumers: ["scratch-*"]
+//
+// Names are matched with path.Match. The stream and consumer in the
+// sidecar-config, which every client depends on, are never changed.
+type jsOwners struct {
+	mu        sync.Mutex
+	streams   map[string]string
+	consumers map[consumerKey]string
+}
+
+type consumerKey struct {
+	stream      string
+	durableName string
+}
+
+func newJSOwners() *jsOwners {
+
+	return &jsOwners{
+		streams:   make(map[string]string),
+		consumers: make(map[consumerKey]string),
+	}
+}
+
+func modifiable(key, name string) bool {
+
+	for _, pattern := range viper.GetStringSlice(key) {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// addedStream records the service as the owner of the stream,
+// unless the stream already had one.
+func (o *jsOwners) addedStream(serviceName, stream string) {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if _, ok := o.streams[stream]; !ok {
+		o.streams[stream] = serviceName
+	}
+}
+
+func (o *jsOwners) addedConsumer(serviceName, stream, durableName string) {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	key := consumerKey{stream, durableName}
+	if _, ok := o.consumers[key]; !ok {
+		o.consumers[key] = serviceName
+	}
+}
+
+// canModifyStream returns an error unless the service may update or
+// delete the stream, as op says.
+func (o *jsOwners) canModifyStream(serviceName string, op streamOp, stream string) error {
+
+	if stream == viper.GetString("nats.jetstream.name") {
+		return fmt.Errorf("Error - clients cannot %s stream: %s, which is the sidecar's own\n", op, stream)
+	}
+
+	o.mu.Lock()
+	owner, ok := o.streams[stream]
+	o.mu.Unlock()
+
+	if (ok && owner == serviceName) || modifiable("nats.jetstream.modifiable.streams", stream) {
+		return nil
+	}
+
+	return fmt.Errorf("Error - service: %s may not %s stream: %s\n", serviceName, op, stream)
+}
+
+func (o *jsOwners) canModifyConsumer(serviceName string, op streamOp, stream, durableName string) error {
+
+	if stream == viper.GetString("nats.jetstream.name") &&
+		durableName == viper.GetString("nats.jetstream.consumer.durableName") {
+		return fmt.Errorf("Error - clients cannot %s consumer: %s on stream: %s, which is the sidecar's own\n",
+			op, durableName, stream)
+	}
+
+	o.mu.Lock()
+	owner, ok := o.consumers[consumerKey{stream, durableName}]
+	o.mu.Unlock()
+
+	if (ok && owner == serviceName) || modifiable("nats.jetstream.modifiable.consumers", durableName) {
+		return nil
+	}
+
+	return fmt.Errorf("Error - service: %s may not %s consumer: %s on stream: %s\n",
+		serviceName, op, durableName, stream)
+}
+
+// deletedStream forgets the stream, and its consumers with it.
+func (o *jsOwners) deletedStream(stream string) {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.streams, stream)
+	for key := range o.consumers {
+		if key.stream == stream {
+			delete(o.consumers, key)
+		}
+	}
+}
+
+func (o *jsOwners) deletedConsumer(stream, durableName string) {
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.consumers, consumerKey{stream, durableName})
+}
@@ -0,0 +1,74 @@
+package conn
+
+import (
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	contentTypeHeader = "Content-Type"
+)
+
+func natsHeader(headers map[string]*pb.HeaderValues) nats.Header {
+
+	if len(headers) == 0 {
+		return nil
+	}
+
+	h := make(nats.Header, len(headers))
+	for k, v := range headers {
+		for _, value := range v.GetValues() {
+			h.Add(k, value)
+		}
+	}
+
+	return h
+}
+
+func pbHeaders(h nats.Header) map[string]*pb.HeaderValues {
+
+	if len(h) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]*pb.HeaderValues, len(h))
+	for k, values := range h {
+		headers[k] = &pb.HeaderValues{Values: values}
+	}
+
+	return headers
+}
+
+// payloadMsg is the NATS message for a payload. Its content type goes in
+// the Content-Type header, in place of any the headers may have.
+func payloadMsg(subject string, payload *pb.Payload) *nats.Msg {
+
+	m := nats.NewMsg(subject)
+	m.Data = payload.GetData()
+
+	if h := natsHeader(payload.GetHeaders()); h != nil {
+		m.Header = h
+	}
+
+	if contentType := payload.GetContentType(); contentType != "" {
+		m.Header.Set(contentTypeHeader, contentType)
+	}
+
+	return m
+}
+
+func msgPayload(m *nats.Msg) *pb.Payload {
+
+	payload := &pb.Payload{
+		Data:        m.Data,
+		ContentType: m.Header.Get(contentTypeHeader),
+	}
+
+	headers := pbHeaders(m.Header)
+	delete(headers, contentTypeHeader)
+	if len(headers) > 0 {
+		payload.Headers = headers
+	}
+
+	return payload
+}
@@ -0,0 +1,36 @@
+package conn
+
+import (
+	"testing"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPayloadMsg(t *testing.T) {
+
+	payload := &pb.Payload{
+		Data:        []byte(`{"embedding":[0.1,0.2]}`),
+		ContentType: "application/json",
+		Headers: map[string]*pb.HeaderValues{
+			"Model": {Values: []string{"small"}},
+			"Tag":   {Values: []string{"a", "b"}},
+		},
+	}
+
+	m := payloadMsg("test.payload", payload)
+	if m.Subject != "test.payload" || m.Header.Get(contentTypeHeader) != "application/json" ||
+		len(m.Header.Values("Tag")) != 2 {
+		t.Errorf("Unexpected NATS message: %+v\n", m)
+	}
+
+	if got := msgPayload(m); !proto.Equal(got, payload) {
+		t.Errorf("Expected payload: %s\nGot: %s\n", payload, got)
+	}
+
+	// A payload with no content type or headers has no NATS headers either.
+	bare := msgPayload(payloadMsg("test.payload", &pb.Payload{Data: []byte("x")}))
+	if bare.ContentType != "" || bare.Headers != nil {
+		t.Errorf("Expected a bare payload. Got: %s\n", bare)
+	}
+}
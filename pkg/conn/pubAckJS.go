@@ -0,0 +1,228 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultPubAckTimeout  = 30 * time.Second
+	defaultPubAckChanSize = 1024
+)
+
+func pbPubAck(ack *nats.PubAck) *pb.PubAck {
+
+	return &pb.PubAck{
+		Stream:    ack.Stream,
+		Sequence:  ack.Sequence,
+		Duplicate: ack.Duplicate,
+	}
+}
+
+// awaitPubAck waits for JetStream to store an asynchronously published
+// message. Without a timeout, a lost reply would leave us waiting forever.
+func awaitPubAck(future nats.PubAckFuture) (*nats.PubAck, error) {
+
+	timeout := viper.GetDuration("nats.jetstream.pubAckTimeout")
+	if timeout <= 0 {
+		timeout = defaultPubAckTimeout
+	}
+
+	select {
+	case ack := <-future.Ok():
+		return ack, nil
+	case err := <-future.Err():
+		return nil, fmt.Errorf("Error - JetStream did not store message on: %s\n\terr: %w",
+			future.Msg().Subject, err)
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("Error - timed out waiting for JetStream to store message on: %s\n",
+			future.Msg().Subject)
+	}
+}
+
+// pubAckResults holds the results of a client's async publishes
+// until the client reads them from PubAcks.
+type pubAckResults struct {
+	results chan *pb.PubAckResult
+	dropped atomic.Uint64
+}
+
+type asyncPubAcks struct {
+	mu        sync.Mutex
+	nextPubId uint64
+	clients   map[string]*pubAckResults
+}
+
+func newAsyncPubAcks() *asyncPubAcks {
+
+	return &asyncPubAcks{
+		clients: make(map[string]*pubAckResults),
+	}
+}
+
+func (a *asyncPubAcks) client(servId string) *pubAckResults {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	r, ok := a.clients[servId]
+	if !ok {
+		size := viper.GetInt("nats.jetstream.pubAckChanSize")
+		if size <= 0 {
+			size = defaultPubAckChanSize
+		}
+
+		r = &pubAckResults{results: make(chan *pb.PubAckResult, size)}
+		a.clients[servId] = r
+	}
+
+	return r
+}
+
+func (a *asyncPubAcks) newPubId() uint64 {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.nextPubId++
+	return a.nextPubId
+}
+
+// report keeps the result for the client. If the client leaves too many
+// results unread, the newest ones are dropped, and counted. Results for
+// a client that is no longer registered are dropped.
+func (a *asyncPubAcks) report(servId string, result *pb.PubAckResult) {
+
+	a.mu.Lock()
+	r, ok := a.clients[servId]
+	a.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	select {
+	case r.results <- result:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+func (a *asyncPubAcks) releaseClient(servId []byte) {
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.clients, string(servId))
+}
+
+// PublishJS publishes to JetStream. In PUB_ACK_SYNC mode it returns once
+// JetStream stored the message. In PUB_ACK_ASYNC mode it returns right away,
+// and the result is reported to the client later.
+func (pubs *Pubs) PublishJS(ctx context.Context, servId []byte, in *pb.PubJSMsg) (*pb.PubJSMsgResponse, error) {
+
+	js, err := pubs.natsConn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	m := withMsgId(&nats.Msg{
+		Subject: in.GetTopic(),
+		Data:    in.GetMsg(),
+		Header:  natsHeader(in.GetHeaders()),
+	}, in.GetIdempotencyKey())
+	if err = pubs.encryption.sealMsg(m); err != nil {
+		return nil, err
+	}
+
+	pubJSMsgRsp := &pb.PubJSMsgResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_PUB_JS_RSP,
+			SrcServType: serviceType(),
+			DstServType: in.Header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg:       "OK",
+		WorkQueue: in.GetWorkQueue(),
+	}
+
+	if in.GetAckMode() == pb.PubAckMode_PUB_ACK_ASYNC {
+		future, err := js.PublishMsgAsync(m)
+		if err != nil {
+			return nil, fmt.Errorf("Error publishing to JetStream with topic: %s\n\terr: %w", m.Subject, err)
+		}
+
+		pubId := pubs.asyncAcks.newPubId()
+		pubJSMsgRsp.PubId = pubId
+
+		// Keep results from here on, even if the client is
+		// not reading them from PubAcks yet.
+		pubs.asyncAcks.client(string(servId))
+
+		go func() {
+			result := &pb.PubAckResult{PubId: pubId}
+
+			ack, err := awaitPubAck(future)
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.PubAck = pbPubAck(ack)
+			}
+
+			pubs.asyncAcks.report(string(servId), result)
+		}()
+
+		return pubJSMsgRsp, nil
+	}
+
+	ack, err := js.PublishMsg(m, nats.Context(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error publishing to JetStream with topic: %s\n\terr: %w", m.Subject, err)
+	}
+	pubJSMsgRsp.PubAck = pbPubAck(ack)
+
+	return pubJSMsgRsp, nil
+}
+
+// StreamPubAcks sends the results of the client's async publishes
+// to the stream, until ctx is done.
+func (pubs *Pubs) StreamPubAcks(ctx context.Context, servId []byte, in *pb.PubAcksMsg,
+	stream pb.Sidecar_PubAcksServer) error {
+
+	r := pubs.asyncAcks.client(string(servId))
+
+	for {
+		select {
+
+		case result := <-r.results:
+			result.Header = &pb.Header{
+				MsgType:     pb.MsgType_MSG_TYPE_PUB_ACK_RESULT,
+				SrcServType: serviceType(),
+				DstServType: in.Header.SrcServType,
+				ServId:      serviceId()(),
+				MsgId:       NextMsgId(),
+			}
+			result.Dropped = r.dropped.Swap(0)
+
+			if err := stream.Send(result); err != nil {
+				return fmt.Errorf("Error sending to pub acks stream: %w", err)
+			}
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
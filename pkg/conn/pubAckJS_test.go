@@ -0,0 +1,109 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+)
+
+func TestPublishJS(t *testing.T) {
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitPubs(natsConn, srv)
+	pubs := srv.Pubs
+
+	name := fmt.Sprintf("testPubAcks%d", time.Now().UnixNano())
+	topic := "test.pubacks." + name
+	if _, err = natsConn.ManageStream(streamAdd, name, &pb.StreamConfig{
+		Name:     name,
+		Subjects: []string{topic},
+		Storage:  pb.StorageType_STORAGE_MEMORY,
+	}); err != nil {
+		t.Fatalf("Error adding stream\n\terr: %v\n", err)
+	}
+	defer natsConn.ManageStream(streamDelete, name, nil)
+
+	header := &pb.Header{SrcServType: "test"}
+	servId := []byte("client")
+
+	rsp, err := pubs.PublishJS(context.Background(), servId,
+		&pb.PubJSMsg{Header: header, Topic: topic, Msg: []byte("sync")})
+	if err != nil || rsp.PubAck.GetStream() != name || rsp.PubAck.GetSequence() != 1 {
+		t.Fatalf("Expected message 1 stored in stream: %s. Got: %s err: %v\n", name, rsp, err)
+	}
+
+	// Nothing stores messages sent to a topic without a stream.
+	if _, err = pubs.PublishJS(context.Background(), servId,
+		&pb.PubJSMsg{Header: header, Topic: "test.pubacks.nostream", Msg: []byte("lost")}); err == nil {
+		t.Errorf("Expected an error publishing to a topic without a stream\n")
+	}
+
+	rsp, err = pubs.PublishJS(context.Background(), servId, &pb.PubJSMsg{
+		Header:  header,
+		Topic:   topic,
+		Msg:     []byte("async"),
+		AckMode: pb.PubAckMode_PUB_ACK_ASYNC,
+	})
+	if err != nil || rsp.PubId == 0 || rsp.PubAck != nil {
+		t.Fatalf("Expected a pubId and no PubAck. Got: %s err: %v\n", rsp, err)
+	}
+
+	select {
+	case result := <-pubs.asyncAcks.client(string(servId)).results:
+		if result.PubId != rsp.PubId || result.PubAck.GetSequence() != 2 || result.Error != "" {
+			t.Errorf("Expected message 2 stored for pubId: %d. Got: %s\n", rsp.PubId, result)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for async publish result\n")
+	}
+
+	// JetStream stores a retry with the same idempotency key only once.
+	for i, dup := range []bool{false, true} {
+		rsp, err = pubs.PublishJS(context.Background(), servId, &pb.PubJSMsg{
+			Header:         header,
+			Topic:          topic,
+			Msg:            []byte("keyed"),
+			IdempotencyKey: "key",
+		})
+		if err != nil || rsp.PubAck.GetSequence() != 3 || rsp.PubAck.GetDuplicate() != dup {
+			t.Errorf("Publish %d: expected message 3 with duplicate: %t. Got: %s err: %v\n",
+				i, dup, rsp, err)
+		}
+	}
+}
+
+func TestAsyncPubAcksDropped(t *testing.T) {
+
+	a := newAsyncPubAcks()
+	r := a.client("client")
+
+	for i := 0; i < cap(r.results)+3; i++ {
+		a.report("client", &pb.PubAckResult{PubId: a.newPubId()})
+	}
+
+	if r.dropped.Load() != 3 {
+		t.Errorf("Expected 3 results dropped. Got: %d\n", r.dropped.Load())
+	}
+
+	a.releaseClient([]byte("client"))
+
+	// Publishes still in flight report after the client is gone.
+	a.report("client", &pb.PubAckResult{PubId: a.newPubId()})
+	if _, ok := a.clients["client"]; ok {
+		t.Errorf("Expected no results kept for a released client\n")
+	}
+
+	if a.client("client") == r {
+		t.Errorf("Expected results released with the client\n")
+	}
+}
@@ -2,29 +2,315 @@ package conn
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/find-in-docs/sidecar/pkg/log"
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
 	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Each client gets its own breaker for each topic it sends requests to.
+// One dead responder should not stop a client from reaching the others.
+type breakerKey struct {
+	servId string
+	topic  string
+}
+
+// Identical messages from a client to a topic share a debouncer.
+// Publishes and requests are debounced separately.
+type debounceKey struct {
+	servId  string
+	topic   string
+	request bool
+	sum     [sha256.Size]byte
+}
+
+type debouncer struct {
+	circuit Circuit
+	expires time.Time
+}
+
+const (
+	debounceSweepPeriod = time.Second
 )
 
 type Pubs struct {
-	msgId     uint32
-	natsConn  *Conn
-	regParams *pb.RegistrationParams
+	msgId    uint32
+	natsConn *Conn
+
+	breakersMu sync.Mutex
+	breakers   map[breakerKey]Circuit
+
+	debouncersMu  sync.Mutex
+	debouncers    map[debounceKey]*debouncer
+	debounceSwept time.Time
+
+	asyncAcks  *asyncPubAcks
+	dedupe     *pubDedupe
+	encryption *Encryption
 }
 
 func InitPubs(natsConn *Conn, srv *Server) {
 
 	srv.Pubs = &Pubs{
-		msgId:     1,
-		natsConn:  natsConn,
-		regParams: srv.regParams, // record registration parameters
+		msgId:      1,
+		natsConn:   natsConn,
+		breakers:   make(map[breakerKey]Circuit),
+		debouncers: make(map[debounceKey]*debouncer),
+		asyncAcks:  newAsyncPubAcks(),
+		dedupe:     newPubDedupe(),
+		encryption: srv.Encryption,
 	}
 }
 
+func debouncePolicy(regParams *pb.RegistrationParams, topic string) (pb.DebounceMode, time.Duration) {
+
+	mode := regParams.GetDebounceMode()
+	delay := regParams.GetDebounceDelay().AsDuration()
+
+	if td, ok := regParams.GetTopicDebounce()[topic]; ok {
+		mode = td.GetMode()
+		if td.GetDelay() != nil {
+			delay = td.GetDelay().AsDuration()
+		}
+	}
+
+	return mode, delay
+}
+
+// msgSum tells identical messages apart from the rest. Messages with
+// the same data but different headers are not identical.
+func msgSum(data []byte, headers nats.Header) [sha256.Size]byte {
+
+	if len(headers) == 0 {
+		return sha256.Sum256(data)
+	}
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	h.Write(data)
+	for _, k := range keys {
+		for _, v := range headers[k] {
+			fmt.Fprintf(h, "\x00%d:%s%d:%s", len(k), k, len(v), v)
+		}
+	}
+
+	var sum [sha256.Size]byte
+	h.Sum(sum[:0])
+
+	return sum
+}
+
+// debouncer wraps the circuit to send this message through, so that
+// bursts of identical messages to a topic get sent only once.
+func (pubs *Pubs) debouncer(client *RegisteredClient, topic string, sum [sha256.Size]byte,
+	request bool, circuit Circuit) Circuit {
+
+	mode, delay := debouncePolicy(client.RegParams, topic)
+	if mode == pb.DebounceMode_DEBOUNCE_OFF || delay <= 0 {
+		return circuit
+	}
+
+	key := debounceKey{string(client.ServId), topic, request, sum}
+	now := time.Now()
+
+	pubs.debouncersMu.Lock()
+	defer pubs.debouncersMu.Unlock()
+
+	// Forget debouncers that have been quiet for a while.
+	if now.Sub(pubs.debounceSwept) > debounceSweepPeriod {
+		for k, d := range pubs.debouncers {
+			if now.After(d.expires) {
+				delete(pubs.debouncers, k)
+			}
+		}
+		pubs.debounceSwept = now
+	}
+
+	d, ok := pubs.debouncers[key]
+	if !ok {
+		d = &debouncer{}
+		if mode == pb.DebounceMode_DEBOUNCE_LAST {
+			d.circuit = DebounceLast(circuit, delay)
+		} else {
+			d.circuit = DebounceFirst(circuit, delay)
+		}
+		pubs.debouncers[key] = d
+	}
+
+	// DebounceLast publishes one delay after the last call,
+	// so keep the debouncer around well past that.
+	d.expires = now.Add(2 * delay)
+
+	return d.circuit
+}
+
+func breakerSettings(regParams *pb.RegistrationParams) BreakerSettings {
+
+	cb := regParams.GetCircuitBreaker()
+
+	return BreakerSettings{
+		FailureThreshold:       uint(regParams.GetCircuitFailureThreshold()),
+		SuccessThreshold:       uint(cb.GetSuccessThreshold()),
+		HalfOpenMaxProbes:      uint(cb.GetHalfOpenMaxProbes()),
+		OpenTimeout:            cb.GetOpenTimeout().AsDuration(),
+		MaxOpenTimeout:         cb.GetMaxOpenTimeout().AsDuration(),
+		FailureRateWindow:      cb.GetFailureRateWindow().AsDuration(),
+		FailureRate:            cb.GetFailureRate(),
+		FailureRateMinRequests: uint(cb.GetFailureRateMinRequests()),
+	}
+}
+
+// breaker returns the circuit to send a request through for this client
+// and topic. Clients that set neither a failure threshold nor a failure
+// rate did not ask for a breaker.
+func (pubs *Pubs) breaker(client *RegisteredClient, topic string) Circuit {
+
+	settings := breakerSettings(client.RegParams)
+	if settings.FailureThreshold == 0 && settings.FailureRate == 0 {
+		return pubs.request
+	}
+
+	key := breakerKey{string(client.ServId), topic}
+
+	pubs.breakersMu.Lock()
+	defer pubs.breakersMu.Unlock()
+
+	circuit, ok := pubs.breakers[key]
+	if !ok {
+		circuit = BreakerWithSettings(pubs.request, settings)
+		pubs.breakers[key] = circuit
+	}
+
+	return circuit
+}
+
+// releaseClient forgets the breakers and debouncers of a client
+// that is no longer registered.
+func (pubs *Pubs) releaseClient(servId []byte) {
+
+	pubs.breakersMu.Lock()
+	for key := range pubs.breakers {
+		if key.servId == string(servId) {
+			delete(pubs.breakers, key)
+		}
+	}
+	pubs.breakersMu.Unlock()
+
+	pubs.debouncersMu.Lock()
+	for key := range pubs.debouncers {
+		if key.servId == string(servId) {
+			delete(pubs.debouncers, key)
+		}
+	}
+	pubs.debouncersMu.Unlock()
+
+	pubs.asyncAcks.releaseClient(servId)
+}
+
+// publish sends the message, with retries, and waits only until
+// the NATS server has it. Nobody has to be listening.
+func (pubs *Pubs) publish(ctx context.Context, msg *Message) (*Message, error) {
+
+	settings := retrySettings(msg.retry)
+
+	m := &nats.Msg{
+		Subject: msg.topic,
+		Data:    msg.data,
+		Header:  msg.headers,
+	}
+	if err := pubs.encryption.sealMsg(m); err != nil {
+		return nil, err
+	}
+
+	// A message NATS did not take can be published again. Once it took
+	// the message, only the flush is retried, or a slow flush would have
+	// the message sent twice.
+	if _, err := RetryFunc(pubs.publishMsg, settings)(ctx, m); err != nil {
+		return nil, err
+	}
+
+	if _, err := RetryFunc(pubs.flush, settings)(ctx, m); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		topic: msg.topic,
+	}, nil
+}
+
+func (pubs *Pubs) publishMsg(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
+
+	return nil, pubs.natsConn.nc.PublishMsg(msg)
+}
+
+func (pubs *Pubs) flush(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
+
+	nc := pubs.natsConn.nc
+
+	// NATS can only flush with a context that has a deadline.
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, nc.FlushTimeout(defaultFlushTimeout)
+	}
+
+	return nil, nc.FlushWithContext(ctx)
+}
+
+// flushPublish publishes the message once, and waits until the NATS
+// server has it.
+func (pubs *Pubs) flushPublish(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
+
+	if _, err := pubs.publishMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return pubs.flush(ctx, msg)
+}
+
+// request sends the message, with retries, and waits for the reply.
+// NATS sends the request with a reply subject on the connection's own inbox.
+// Responders seal the reply with the keys of the request's topic.
+func (pubs *Pubs) request(ctx context.Context, msg *Message) (*Message, error) {
+
+	retryFunc := RetryFunc(pubs.Retry, retrySettings(msg.retry))
+
+	m := &nats.Msg{
+		Subject: msg.topic,
+		Data:    msg.data,
+	}
+	if err := pubs.encryption.sealMsg(m); err != nil {
+		return nil, err
+	}
+
+	reply, err := retryFunc(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	data, _, err := pubs.encryption.openFrom(msg.topic, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		topic: reply.Subject,
+		data:  data,
+	}, nil
+}
+
 type Effector func(context.Context, *nats.Msg) (*nats.Msg, error)
 
 func (pubs *Pubs) Retry(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
@@ -32,50 +318,181 @@ func (pubs *Pubs) Retry(ctx context.Context, msg *nats.Msg) (*nats.Msg, error) {
 	return pubs.natsConn.nc.RequestMsgWithContext(ctx, msg)
 }
 
-func RetryFunc(effector Effector, retries int, delay time.Duration) Effector {
+const (
+	defaultFlushTimeout = 5 * time.Second
+
+	defaultRetryMultiplier = 2
+	defaultRetryMaxDelay   = 30 * time.Second
+)
+
+// RetrySettings configures RetryFunc. Zero values take the defaults.
+type RetrySettings struct {
+	Retries        int
+	Delay          time.Duration
+	MaxDelay       time.Duration
+	Multiplier     float64
+	Jitter         float64
+	AttemptTimeout time.Duration
+
+	// Retry only on these classes of errors. Retries on all of them if empty.
+	RetryOn []pb.RetryableError
+}
+
+func retrySettings(rb *pb.RetryBehavior) RetrySettings {
+
+	settings := RetrySettings{
+		Retries:        int(rb.GetRetryNum()),
+		Delay:          rb.GetRetryDelay().AsDuration(),
+		MaxDelay:       rb.GetMaxDelay().AsDuration(),
+		Multiplier:     rb.GetMultiplier(),
+		Jitter:         rb.GetJitter(),
+		AttemptTimeout: rb.GetAttemptTimeout().AsDuration(),
+		RetryOn:        rb.GetRetryOn(),
+	}
+
+	if settings.Multiplier < 1 {
+		settings.Multiplier = defaultRetryMultiplier
+	}
+
+	if settings.MaxDelay <= 0 {
+		settings.MaxDelay = defaultRetryMaxDelay
+	}
+
+	if settings.Jitter < 0 {
+		settings.Jitter = 0
+	} else if settings.Jitter > 1 {
+		settings.Jitter = 1
+	}
+
+	// Before the retry behavior had its own attempt timeout,
+	// the retry delay was used for it.
+	if settings.AttemptTimeout <= 0 {
+		settings.AttemptTimeout = settings.Delay
+	}
+
+	return settings
+}
+
+// classifyError returns the class of a retryable error.
+// Any other error is not worth retrying.
+func classifyError(err error) (pb.RetryableError, bool) {
+
+	switch {
+	case errors.Is(err, nats.ErrNoResponders):
+		return pb.RetryableError_RETRY_NO_RESPONDERS, true
+
+	case errors.Is(err, nats.ErrTimeout),
+		errors.Is(err, context.DeadlineExceeded):
+		return pb.RetryableError_RETRY_TIMEOUT, true
+
+	case errors.Is(err, nats.ErrConnectionClosed),
+		errors.Is(err, nats.ErrConnectionReconnecting),
+		errors.Is(err, nats.ErrConnectionDraining),
+		errors.Is(err, nats.ErrDisconnected):
+		return pb.RetryableError_RETRY_CONNECTION, true
+	}
+
+	return 0, false
+}
+
+func (settings *RetrySettings) retryable(err error) bool {
+
+	class, ok := classifyError(err)
+	if !ok {
+		return false
+	}
+
+	if len(settings.RetryOn) == 0 {
+		return true
+	}
+
+	for _, c := range settings.RetryOn {
+		if c == class {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns the delay before retry number r, counting from 0.
+func (settings *RetrySettings) backoff(r int) time.Duration {
+
+	delay := float64(settings.Delay) * math.Pow(settings.Multiplier, float64(r))
+	if delay > float64(settings.MaxDelay) {
+		delay = float64(settings.MaxDelay)
+	}
+
+	if settings.Jitter > 0 {
+		delay += delay * settings.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(delay)
+}
+
+func RetryFunc(effector Effector, settings RetrySettings) Effector {
 
 	return func(ctx context.Context, m *nats.Msg) (*nats.Msg, error) {
 
 		for r := 0; ; r++ {
 
-			ctx2, cancel := context.WithTimeout(context.Background(), delay)
-			defer cancel()
+			// Each attempt gets its own timeout, but never outlives the caller.
+			attemptCtx := ctx
+			cancel := context.CancelFunc(func() {})
+			if settings.AttemptTimeout > 0 {
+				attemptCtx, cancel = context.WithTimeout(ctx, settings.AttemptTimeout)
+			}
 
 			fmt.Printf("--- Publishing msg\n")
-			response, err := effector(ctx2, m)
-			if err == nil || r >= retries {
+			response, err := effector(attemptCtx, m)
+			cancel()
+
+			if err == nil || r >= settings.Retries {
 				return response, err
 			}
 
-			fmt.Printf("Attempt %d failed; \n\terr: %v\n\tretrying in %v", r+1, err, delay)
+			// The caller cancelled, or ran out of time.
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			if !settings.retryable(err) {
+				return nil, err
+			}
+
+			delay := settings.backoff(r)
+
+			// Do not wait for a retry there is no time left for.
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+				return nil, err
+			}
+
+			fmt.Printf("Attempt %d failed; \n\terr: %v\n\tretrying in %v\n", r+1, err, delay)
 
+			timer := time.NewTimer(delay)
 			select {
-			case <-ctx2.Done():
-				return nil, ctx2.Err()
-			default:
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
 			}
 		}
 	}
 }
 
-func (pubs *Pubs) Publish(ctx context.Context, logger *log.Logger, in *pb.PubMsg,
-	retryBehavior *pb.RetryBehavior) (*pb.PubMsgResponse, error) {
+func (pubs *Pubs) Publish(ctx context.Context, logger *log.Logger, client *RegisteredClient,
+	in *pb.PubMsg, retryBehavior *pb.RetryBehavior) (*pb.PubMsgResponse, error) {
 
 	topic := in.GetTopic()
-	responseTopic := topic + "_" + string(in.Header.MsgId) + "_response"
 	data := in.GetMsg()
 
-	// pubs.natsConn.Publish(topic, data)
-	msg := nats.Msg{
-		Subject: topic,
-		Reply:   responseTopic,
-		Data:    data,
+	msg := Message{
+		topic:   topic,
+		data:    data,
+		headers: natsHeader(in.GetHeaders()),
+		retry:   retryBehavior,
 	}
 
-	var retryFunc Effector
-	retryFunc = RetryFunc(pubs.Retry, int(retryBehavior.RetryNum), retryBehavior.RetryDelay.AsDuration())
-
-	reply, err := retryFunc(ctx, &msg)
 	header := pb.Header{
 		MsgType:     pb.MsgType_MSG_TYPE_PUB_RSP,
 		SrcServType: serviceType(),
@@ -84,10 +501,32 @@ func (pubs *Pubs) Publish(ctx context.Context, logger *log.Logger, in *pb.PubMsg
 		MsgId:       NextMsgId(),
 	}
 
+	key := in.GetIdempotencyKey()
+	if key != "" && !pubs.dedupe.reserve(topic, key) {
+
+		logger.Log("Duplicate msg not published to topic: %s\n\tidempotencyKey: %s\n", topic, key)
+		return &pb.PubMsgResponse{
+			Header: &header,
+
+			RspHeader: &pb.ResponseHeader{
+				Status: uint32(pb.Status_OK),
+			},
+
+			Msg:       "OK",
+			Duplicate: true,
+		}, nil
+	}
+
+	reply, err := pubs.debouncer(client, topic, msgSum(data, msg.headers), false, pubs.publish)(ctx, &msg)
 	if err != nil {
 
-		logger.Log("Error publishing msg: %s\n\tto topic: %s\n\tresponse topic: %s\n\terr: %s",
-			in, topic, responseTopic, err.Error())
+		// Let the client retry with the same key.
+		if key != "" {
+			pubs.dedupe.release(topic, key)
+		}
+
+		logger.Log("Error publishing msg: %s\n\tto topic: %s\n\terr: %s",
+			in, topic, err.Error())
 		return &pb.PubMsgResponse{
 			Header: &header,
 
@@ -95,8 +534,8 @@ func (pubs *Pubs) Publish(ctx context.Context, logger *log.Logger, in *pb.PubMsg
 				Status: uint32(pb.Status_ERR_PUBLISHING),
 			},
 
-			Msg: fmt.Sprintf("Error publishing msg: %s\n\tto topic: %s\n\tresponse topic: %s\n",
-				string(data), topic, responseTopic),
+			Msg: fmt.Sprintf("Error publishing msg: %s\n\tto topic: %s\n",
+				string(data), topic),
 		}, err
 	} else {
 
@@ -107,7 +546,139 @@ func (pubs *Pubs) Publish(ctx context.Context, logger *log.Logger, in *pb.PubMsg
 				Status: uint32(pb.Status_OK),
 			},
 
-			Msg: string(reply.Data),
+			Msg:       "OK",
+			Debounced: reply.debounced,
+			Collapsed: reply.collapsed,
+		}, nil
+	}
+}
+
+func (pubs *Pubs) Request(ctx context.Context, logger *log.Logger, client *RegisteredClient,
+	in *pb.RequestMsg, retryBehavior *pb.RetryBehavior) (*pb.RequestMsgResponse, error) {
+
+	topic := in.GetTopic()
+	data := in.GetMsg()
+
+	if timeout := in.GetTimeout().AsDuration(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	msg := Message{
+		topic: topic,
+		data:  data,
+		retry: retryBehavior,
+	}
+
+	circuit := pubs.debouncer(client, topic, msgSum(data, nil), true, pubs.breaker(client, topic))
+	reply, err := circuit(ctx, &msg)
+	header := pb.Header{
+		MsgType:     pb.MsgType_MSG_TYPE_REQ_RSP,
+		SrcServType: serviceType(),
+		DstServType: in.Header.SrcServType,
+		ServId:      serviceId()(),
+		MsgId:       NextMsgId(),
+	}
+
+	var circuitOpen *CircuitOpenError
+	if errors.As(err, &circuitOpen) {
+
+		// Fail fast. This is not an error in the sidecar, so the client
+		// gets the response, and can tell how long to back off for.
+		logger.Log("Not sending request to topic: %s\n\terr: %s\n", topic, err.Error())
+		return &pb.RequestMsgResponse{
+			Header: &header,
+
+			RspHeader: &pb.ResponseHeader{
+				Status: uint32(pb.Status_ERR_CIRCUIT_OPEN),
+			},
+
+			Msg:        err.Error(),
+			RetryAfter: durationpb.New(circuitOpen.RetryIn),
 		}, nil
 	}
+
+	if err != nil {
+
+		logger.Log("Error sending request: %s\n\tto topic: %s\n\terr: %s",
+			in, topic, err.Error())
+		return &pb.RequestMsgResponse{
+			Header: &header,
+
+			RspHeader: &pb.ResponseHeader{
+				Status: uint32(pb.Status_ERR_PUBLISHING),
+			},
+
+			Msg: fmt.Sprintf("Error sending request: %s\n\tto topic: %s\n",
+				string(data), topic),
+		}, err
+	}
+
+	return &pb.RequestMsgResponse{
+		Header: &header,
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg:       "OK",
+		Reply:     reply.data,
+		Debounced: reply.debounced,
+		Collapsed: reply.collapsed,
+	}, nil
+}
+
+// Respond answers a request that a client received through the sidecar.
+// The reply is sealed with the keys of the topic the request was sent to.
+func (pubs *Pubs) Respond(ctx context.Context, logger *log.Logger,
+	in *pb.RespondMsg, request *pendingReply) (*pb.RespondMsgResponse, error) {
+
+	reply := request.subject
+
+	header := pb.Header{
+		MsgType:     pb.MsgType_MSG_TYPE_RESPOND_RSP,
+		SrcServType: serviceType(),
+		DstServType: in.Header.SrcServType,
+		ServId:      serviceId()(),
+		MsgId:       NextMsgId(),
+	}
+
+	if reply == "" {
+		return nil, fmt.Errorf("Error - no reply subject to respond to\n")
+	}
+
+	data, sealedHeader, err := pubs.encryption.seal(request.topic, in.GetMsg(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = pubs.flushPublish(ctx, &nats.Msg{
+		Subject: reply,
+		Data:    data,
+		Header:  sealedHeader,
+	})
+	if err != nil {
+
+		logger.Log("Error responding to: %s\n\terr: %s", reply, err.Error())
+		return &pb.RespondMsgResponse{
+			Header: &header,
+
+			RspHeader: &pb.ResponseHeader{
+				Status: uint32(pb.Status_ERR_PUBLISHING),
+			},
+
+			Msg: fmt.Sprintf("Error responding to: %s\n", reply),
+		}, err
+	}
+
+	return &pb.RespondMsgResponse{
+		Header: &header,
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg: "OK",
+	}, nil
 }
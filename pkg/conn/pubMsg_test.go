@@ -1,10 +1,17 @@
 package conn
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/find-in-docs/sidecar/pkg/log"
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -16,7 +23,7 @@ func TestPublish(t *testing.T) {
 		t.Errorf("Error initializing sidecar - Exiting\n")
 	}
 
-	err := sidecar.Pub("search.testdata.v1", []byte("Test data"), nil)
+	err := sidecar.Pub(context.Background(), "search.testdata.v1", []byte("Test data"), nil)
 	if err != nil {
 		t.Errorf("Error publishing data without retries.\n\terr: %v\n", err)
 	}
@@ -28,12 +35,235 @@ func TestPublish(t *testing.T) {
 	}
 	retryDelay := durationpb.New(retryDelayDuration)
 
-	err = sidecar.Pub("search.testdata.v1", []byte("Test data with retries"),
+	err = sidecar.Pub(context.Background(), "search.testdata.v1", []byte("Test data with retries"),
 		&pb.RetryBehavior{
-			RetryNum:   &retryNum,
+			RetryNum:   retryNum,
 			RetryDelay: retryDelay,
 		})
 	if err != nil {
 		t.Errorf("Error publishing data with retries.\n\terr: %v\n", err)
 	}
 }
+
+func TestRetryFunc(t *testing.T) {
+
+	attempts := 0
+	noResponders := func(ctx context.Context, m *nats.Msg) (*nats.Msg, error) {
+		attempts++
+		return nil, nats.ErrNoResponders
+	}
+
+	settings := retrySettings(&pb.RetryBehavior{
+		RetryNum:   3,
+		RetryDelay: durationpb.New(10 * time.Millisecond),
+	})
+
+	_, err := RetryFunc(noResponders, settings)(context.Background(), &nats.Msg{})
+	if !errors.Is(err, nats.ErrNoResponders) || attempts != 4 {
+		t.Errorf("Expected 4 attempts. Got: %d\n\terr: %v\n", attempts, err)
+	}
+
+	// Only retry on timeouts.
+	attempts = 0
+	settings.RetryOn = []pb.RetryableError{pb.RetryableError_RETRY_TIMEOUT}
+	RetryFunc(noResponders, settings)(context.Background(), &nats.Msg{})
+	if attempts != 1 {
+		t.Errorf("Expected no retries on no responders. Got: %d attempts\n", attempts)
+	}
+
+	// Stop retrying as soon as the caller gives up.
+	attempts = 0
+	settings.RetryOn = nil
+	settings.Delay = time.Minute
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = RetryFunc(noResponders, settings)(ctx, &nats.Msg{})
+	if !errors.Is(err, context.Canceled) || time.Since(start) > 5*time.Second {
+		t.Errorf("Expected retries to stop when cancelled.\n\terr: %v\n", err)
+	}
+}
+
+func TestPublishHeaders(t *testing.T) {
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitPubs(natsConn, srv)
+	InitSubs(natsConn, srv)
+
+	topic := fmt.Sprintf("test.headers.%d", time.Now().UnixNano())
+	sub, err := srv.Subs.subscribe([]byte("client"), &pb.SubMsg{Topic: topic, ChanSize: 1})
+	if err != nil {
+		t.Fatalf("Error subscribing\n\terr: %v\n", err)
+	}
+	defer srv.Subs.remove(sub)
+
+	headers := map[string]*pb.HeaderValues{
+		"Tenant":         {Values: []string{"acme"}},
+		"Correlation-Id": {Values: []string{"a", "b"}},
+	}
+
+	if _, err = srv.Pubs.publish(context.Background(), &Message{
+		topic:   topic,
+		data:    []byte("hello"),
+		headers: natsHeader(headers),
+	}); err != nil {
+		t.Fatalf("Error publishing\n\terr: %v\n", err)
+	}
+
+	select {
+	case m := <-sub.msgs:
+		rsp, _ := srv.Subs.subTopicResponse(&pb.Header{}, sub, m)
+		if !proto.Equal(&pb.SubTopicResponse{Headers: rsp.Headers}, &pb.SubTopicResponse{Headers: headers}) {
+			t.Errorf("Expected headers: %v\nGot: %v\n", headers, rsp.Headers)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Did not get the message\n")
+	}
+
+	// Messages that differ only in their headers are not debounced together.
+	if msgSum([]byte("x"), natsHeader(headers)) == msgSum([]byte("x"), nil) {
+		t.Errorf("Expected headers to change the message sum\n")
+	}
+}
+
+func TestPublishOnce(t *testing.T) {
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitPubs(natsConn, srv)
+
+	topic := fmt.Sprintf("test.once.%d", time.Now().UnixNano())
+	received := make(chan *nats.Msg, 10)
+	sub, err := natsConn.nc.ChanSubscribe(topic, received)
+	if err != nil {
+		t.Fatalf("Error subscribing\n\terr: %v\n", err)
+	}
+	defer sub.Unsubscribe()
+	natsConn.nc.Flush()
+
+	// Every flush times out, and is retried. The message must not be.
+	_, err = srv.Pubs.publish(context.Background(), &Message{
+		topic: topic,
+		data:  []byte("once"),
+		retry: &pb.RetryBehavior{
+			RetryNum:       2,
+			RetryDelay:     durationpb.New(time.Millisecond),
+			AttemptTimeout: durationpb.New(time.Nanosecond),
+		},
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the flush to time out. Got: %v\n", err)
+	}
+
+	natsConn.nc.Flush()
+	time.Sleep(100 * time.Millisecond)
+	if n := len(received); n != 1 {
+		t.Errorf("Expected the message published once. Got: %d\n", n)
+	}
+}
+
+func TestRespond(t *testing.T) {
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	keyFile := filepath.Join(t.TempDir(), "requests")
+	appendTestKey(t, keyFile, "k1")
+
+	topic := fmt.Sprintf("test.respond.%d", time.Now().UnixNano())
+
+	srv := &Server{
+		Registry: NewRegistry(),
+		Logs:     &Logs{logger: log.NewLogger(natsConn.nc, &pb.Header{})},
+	}
+	if srv.Encryption, err = newEncryption([]topicKeyFile{{Topic: topic, KeyFile: keyFile}}, 0); err != nil {
+		t.Fatalf("Error loading keys\n\terr: %v\n", err)
+	}
+	InitPubs(natsConn, srv)
+	InitSubs(natsConn, srv)
+	InitRateLimits(srv)
+
+	responder := srv.Registry.Add([]byte("responder"), "testing", &pb.RegistrationParams{})
+	other := srv.Registry.Add([]byte("other"), "testing", &pb.RegistrationParams{})
+
+	sub, err := srv.Subs.subscribe(responder.ServId, &pb.SubMsg{Topic: topic, ChanSize: 1})
+	if err != nil {
+		t.Fatalf("Error subscribing\n\terr: %v\n", err)
+	}
+	defer srv.Subs.remove(sub)
+
+	replies := make(chan *Message, 1)
+	go func() {
+		reply, err := srv.Pubs.request(context.Background(), &Message{
+			topic: topic,
+			data:  []byte("ping"),
+			retry: &pb.RetryBehavior{AttemptTimeout: durationpb.New(5 * time.Second)},
+		})
+		if err != nil {
+			t.Errorf("Error sending request\n\terr: %v\n", err)
+		}
+		replies <- reply
+	}()
+
+	var m *nats.Msg
+	select {
+	case m = <-sub.msgs:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Did not get the request\n")
+	}
+
+	if string(m.Data) == "ping" {
+		t.Errorf("Expected the request sealed on its way through NATS\n")
+	}
+
+	rsp, err := srv.Subs.subTopicResponse(&pb.Header{}, sub, m)
+	if err != nil || string(rsp.Msg) != "ping" || rsp.Reply == "" || rsp.Reply == m.Reply {
+		t.Fatalf("Expected the request opened, with a reply token. Got: %s err: %v\n", rsp, err)
+	}
+
+	respond := func(client *RegisteredClient, reply string) error {
+		_, err := srv.Respond(context.Background(), &pb.RespondMsg{
+			Header: &pb.Header{ServId: client.ServId},
+			Reply:  reply,
+			Msg:    []byte("pong"),
+		})
+		return err
+	}
+
+	if err = respond(responder, m.Reply); err == nil {
+		t.Errorf("Expected a subject that is not a reply token to be rejected\n")
+	}
+	if err = respond(other, rsp.Reply); err == nil {
+		t.Errorf("Expected another client's reply token to be rejected\n")
+	}
+	if err = respond(responder, rsp.Reply); err != nil {
+		t.Fatalf("Error responding\n\terr: %v\n", err)
+	}
+	if err = respond(responder, rsp.Reply); err == nil {
+		t.Errorf("Expected a reply token to be good for one response\n")
+	}
+
+	select {
+	case reply := <-replies:
+		if reply == nil || string(reply.data) != "pong" {
+			t.Errorf("Expected the reply opened. Got: %v\n", reply)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Did not get the reply\n")
+	}
+}
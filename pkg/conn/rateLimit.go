@@ -0,0 +1,334 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Buckets that are full and have nothing queued are forgotten, since
+// creating them again gives the same bucket. They are swept this often.
+const (
+	rateLimitSweepPeriod = 10 * time.Second
+)
+
+// RateLimitError is returned when a message is over the limit and cannot
+// wait for a token. GRPC sends it to the client as RESOURCE_EXHAUSTED,
+// with RetryAfter in a RetryInfo detail.
+type RateLimitError struct {
+	Scope      string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+
+	return fmt.Sprintf("rate limit exceeded for %s, retry after %v", e.Scope, e.RetryAfter)
+}
+
+func (e *RateLimitError) GRPCStatus() *status.Status {
+
+	st := status.New(codes.ResourceExhausted, e.Error())
+
+	withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(e.RetryAfter),
+	})
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// tokenBucket lets rate messages through per second, and up to burst
+// messages at once. Tokens may go negative. That is how messages queue
+// up: each one waits until the tokens it took are paid back.
+type tokenBucket struct {
+	rate      float64
+	burst     float64
+	maxQueued uint32
+	maxWait   time.Duration
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+	queued uint32
+}
+
+// newTokenBucket returns nil if the limit does not limit anything.
+func newTokenBucket(limit *pb.RateLimit) *tokenBucket {
+
+	if limit.GetRate() <= 0 {
+		return nil
+	}
+
+	burst := float64(limit.GetBurst())
+	if burst == 0 {
+		burst = math.Max(1, math.Ceil(limit.GetRate()))
+	}
+
+	return &tokenBucket{
+		rate:      limit.GetRate(),
+		burst:     burst,
+		maxQueued: limit.GetMaxQueued(),
+		maxWait:   limit.GetMaxWait().AsDuration(),
+		tokens:    burst,
+		last:      time.Now(),
+	}
+}
+
+// reserve takes a token, and returns how long to wait before using it.
+// If the message cannot wait that long, no token is taken, and false
+// is returned along with the wait.
+func (b *tokenBucket) reserve(now time.Time) (time.Duration, bool) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.After(b.last) {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0, true
+	}
+
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	if b.queued >= b.maxQueued || (b.maxWait > 0 && wait > b.maxWait) {
+		b.tokens++
+		return wait, false
+	}
+
+	b.queued++
+	return wait, true
+}
+
+// idle returns true if the bucket refilled completely, and nothing waits on it.
+func (b *tokenBucket) idle(now time.Time) bool {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.queued == 0 && b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst
+}
+
+// dequeue is called once a queued message is done waiting.
+func (b *tokenBucket) dequeue(wait time.Duration) {
+
+	if wait <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.queued--
+}
+
+// cancel gives back a token that was not used.
+func (b *tokenBucket) cancel(wait time.Duration) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+	if wait > 0 {
+		b.queued--
+	}
+}
+
+// Topic overrides in the sidecar-config look like:
+//
+//	rateLimits:
+//	  topics:
+//	    - topic: "search.log.>"
+//	      rate: 100
+//	      burst: 200
+//	      maxQueued: 50
+//	      maxWait: 1s
+//
+// The first matching entry wins.
+type topicRateLimit struct {
+	Topic     string        `mapstructure:"topic"`
+	Rate      float64       `mapstructure:"rate"`
+	Burst     uint32        `mapstructure:"burst"`
+	MaxQueued uint32        `mapstructure:"maxQueued"`
+	MaxWait   time.Duration `mapstructure:"maxWait"`
+}
+
+type rateLimitKey struct {
+	servId string
+	topic  string
+}
+
+type RateLimits struct {
+	overrides []topicRateLimit
+
+	mu      sync.Mutex
+	clients map[string]*tokenBucket
+	topics  map[rateLimitKey]*tokenBucket
+	swept   time.Time
+}
+
+func InitRateLimits(srv *Server) {
+
+	var overrides []topicRateLimit
+	if err := viper.UnmarshalKey("rateLimits.topics", &overrides); err != nil {
+		fmt.Printf("Error reading rateLimits.topics from config: %v\n", err)
+	}
+
+	srv.RateLimits = &RateLimits{
+		overrides: overrides,
+		clients:   make(map[string]*tokenBucket),
+		topics:    make(map[rateLimitKey]*tokenBucket),
+		swept:     time.Now(),
+	}
+}
+
+func (r *RateLimits) topicLimit(regParams *pb.RegistrationParams, topic string) *pb.RateLimit {
+
+	for _, o := range r.overrides {
+		if subjectMatches(o.Topic, topic) {
+			return &pb.RateLimit{
+				Rate:      o.Rate,
+				Burst:     o.Burst,
+				MaxQueued: o.MaxQueued,
+				MaxWait:   durationpb.New(o.MaxWait),
+			}
+		}
+	}
+
+	return regParams.GetTopicRateLimit()
+}
+
+// buckets returns the client bucket and the topic bucket, either of which
+// may be nil. Buckets are created on first use, and kept until they are
+// idle or the client goes away. Unlimited ones are not kept at all.
+func (r *RateLimits) buckets(client *RegisteredClient, topic string) (*tokenBucket, *tokenBucket) {
+
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Sub(r.swept) > rateLimitSweepPeriod {
+		for servId, b := range r.clients {
+			if b.idle(now) {
+				delete(r.clients, servId)
+			}
+		}
+		for k, b := range r.topics {
+			if b.idle(now) {
+				delete(r.topics, k)
+			}
+		}
+		r.swept = now
+	}
+
+	servId := string(client.ServId)
+
+	clientBucket, ok := r.clients[servId]
+	if !ok {
+		clientBucket = newTokenBucket(client.RegParams.GetRateLimit())
+		if clientBucket != nil {
+			r.clients[servId] = clientBucket
+		}
+	}
+
+	key := rateLimitKey{servId: servId, topic: topic}
+	topicBucket, ok := r.topics[key]
+	if !ok {
+		topicBucket = newTokenBucket(r.topicLimit(client.RegParams, topic))
+		if topicBucket != nil {
+			r.topics[key] = topicBucket
+		}
+	}
+
+	return clientBucket, topicBucket
+}
+
+// Wait blocks until the client may send a message to topic. It returns
+// a RateLimitError right away if the message would have to wait longer
+// than the limits allow.
+func (r *RateLimits) Wait(ctx context.Context, client *RegisteredClient, topic string) error {
+
+	clientBucket, topicBucket := r.buckets(client, topic)
+
+	now := time.Now()
+
+	var clientWait time.Duration
+	if clientBucket != nil {
+		var ok bool
+		clientWait, ok = clientBucket.reserve(now)
+		if !ok {
+			return &RateLimitError{Scope: "service " + client.ServiceName, RetryAfter: clientWait}
+		}
+	}
+
+	var topicWait time.Duration
+	if topicBucket != nil {
+		var ok bool
+		topicWait, ok = topicBucket.reserve(now)
+		if !ok {
+			if clientBucket != nil {
+				clientBucket.cancel(clientWait)
+			}
+			return &RateLimitError{Scope: "topic " + topic, RetryAfter: topicWait}
+		}
+	}
+
+	wait := clientWait
+	if topicWait > wait {
+		wait = topicWait
+	}
+
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		if clientBucket != nil {
+			clientBucket.dequeue(clientWait)
+		}
+		if topicBucket != nil {
+			topicBucket.dequeue(topicWait)
+		}
+		return nil
+
+	case <-ctx.Done():
+		if clientBucket != nil {
+			clientBucket.cancel(clientWait)
+		}
+		if topicBucket != nil {
+			topicBucket.cancel(topicWait)
+		}
+		return ctx.Err()
+	}
+}
+
+func (r *RateLimits) releaseClient(servId []byte) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.clients, string(servId))
+	for k := range r.topics {
+		if k.servId == string(servId) {
+			delete(r.topics, k)
+		}
+	}
+}
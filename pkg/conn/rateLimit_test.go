@@ -0,0 +1,142 @@
+package conn
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRateLimits(t *testing.T) {
+
+	limits := &RateLimits{
+		overrides: []topicRateLimit{
+			{Topic: "limited.>", Rate: 10, Burst: 1},
+		},
+		clients: make(map[string]*tokenBucket),
+		topics:  make(map[rateLimitKey]*tokenBucket),
+	}
+
+	registry := NewRegistry()
+	client := registry.Add([]byte("client"), "test", &pb.RegistrationParams{
+		RateLimit: &pb.RateLimit{
+			Rate:      100,
+			Burst:     2,
+			MaxQueued: 1,
+			MaxWait:   durationpb.New(time.Second),
+		},
+	})
+
+	ctx := context.Background()
+
+	// The burst goes through, the next message queues, and
+	// the one after that is rejected since the queue is full.
+	for i := 0; i < 2; i++ {
+		if err := limits.Wait(ctx, client, "open"); err != nil {
+			t.Errorf("Error sending burst message %d\n\terr: %v\n", i, err)
+		}
+	}
+
+	queued := make(chan error, 1)
+	go func() {
+		queued <- limits.Wait(ctx, client, "open")
+	}()
+	time.Sleep(2 * time.Millisecond)
+
+	err := limits.Wait(ctx, client, "open")
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter <= 0 {
+		t.Errorf("Error: expected rate limit error with retry after\n\terr: %v\n", err)
+	}
+
+	if st, _ := status.FromError(err); st.Code() != codes.ResourceExhausted {
+		t.Errorf("Error: expected RESOURCE_EXHAUSTED, got: %v\n", st.Code())
+	}
+
+	if err := <-queued; err != nil {
+		t.Errorf("Error sending queued message\n\terr: %v\n", err)
+	}
+
+	// The topic override allows one message at a time, with no queueing.
+	time.Sleep(20 * time.Millisecond)
+	if err := limits.Wait(ctx, client, "limited.topic"); err != nil {
+		t.Errorf("Error sending to limited topic\n\terr: %v\n", err)
+	}
+	if err := limits.Wait(ctx, client, "limited.topic"); err == nil {
+		t.Errorf("Error: expected second message to limited topic to be rejected\n")
+	}
+}
+
+func TestRateLimitBuckets(t *testing.T) {
+
+	limits := &RateLimits{
+		overrides: []topicRateLimit{
+			{Topic: "limited.>", Rate: 1000, Burst: 1},
+		},
+		clients: make(map[string]*tokenBucket),
+		topics:  make(map[rateLimitKey]*tokenBucket),
+	}
+
+	registry := NewRegistry()
+	unlimited := registry.Add([]byte("unlimited"), "test", &pb.RegistrationParams{})
+	client := registry.Add([]byte("client"), "test", &pb.RegistrationParams{
+		RateLimit: &pb.RateLimit{Rate: 1000, Burst: 1},
+	})
+
+	ctx := context.Background()
+
+	if err := limits.Wait(ctx, unlimited, "open"); err != nil {
+		t.Fatalf("Error sending unlimited message\n\terr: %v\n", err)
+	}
+	if len(limits.clients) != 0 || len(limits.topics) != 0 {
+		t.Errorf("Expected no buckets kept for unlimited messages. Got: %d client, %d topic\n",
+			len(limits.clients), len(limits.topics))
+	}
+
+	if err := limits.Wait(ctx, client, "limited.a"); err != nil {
+		t.Fatalf("Error sending limited message\n\terr: %v\n", err)
+	}
+	if len(limits.clients) != 1 || len(limits.topics) != 1 {
+		t.Errorf("Expected a client and a topic bucket. Got: %d client, %d topic\n",
+			len(limits.clients), len(limits.topics))
+	}
+
+	// Both buckets refill within a few milliseconds, and go at the next sweep.
+	time.Sleep(5 * time.Millisecond)
+	limits.swept = time.Time{}
+
+	if err := limits.Wait(ctx, unlimited, "open"); err != nil {
+		t.Fatalf("Error sending unlimited message\n\terr: %v\n", err)
+	}
+	if len(limits.clients) != 0 || len(limits.topics) != 0 {
+		t.Errorf("Expected idle buckets to be swept. Got: %d client, %d topic\n",
+			len(limits.clients), len(limits.topics))
+	}
+}
+
+func TestSubjectMatches(t *testing.T) {
+
+	tests := []struct {
+		pattern, subject string
+		want             bool
+	}{
+		{"a.b", "a.b", true},
+		{"a.*", "a.b", true},
+		{"a.*", "a.b.c", false},
+		{"a.>", "a.b.c", true},
+		{"a.>", "a", false},
+		{"a.b.c", "a.b", false},
+	}
+
+	for _, test := range tests {
+		if got := subjectMatches(test.pattern, test.subject); got != test.want {
+			t.Errorf("Error matching pattern: %s subject: %s got: %v\n",
+				test.pattern, test.subject, got)
+		}
+	}
+}
@@ -35,16 +35,21 @@ func InitSC(t *testing.T) *client.SC {
 	retryDelay := durationpb.New(retryDelayDuration)
 
 	rParams := &pb.RegistrationParams{
-		CircuitFailureThreshold: &circuitConsecutiveFailures,
+		CircuitFailureThreshold: circuitConsecutiveFailures,
 		DebounceDelay:           debounceDelay,
 
 		Retry: &pb.RetryBehavior{
-			RetryNum:   &retryNum,
+			RetryNum:   retryNum,
 			RetryDelay: retryDelay,
 		},
 	}
 
-	return client.InitSidecar("testing", rParams)
+	sidecar, err := client.InitSidecar("testing", rParams)
+	if err != nil {
+		t.Fatalf("Error initializing sidecar.\n\terr: %v\n", err)
+	}
+
+	return sidecar
 }
 
 func TestRegsitration(t *testing.T) {
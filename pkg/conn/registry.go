@@ -0,0 +1,177 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+)
+
+// A single sidecar can front many services. Each one registers separately,
+// and gets its own service ID and registration parameters.
+type RegisteredClient struct {
+	ServId       []byte
+	ServiceName  string
+	RegParams    *pb.RegistrationParams
+	RegisteredAt time.Time
+
+	// ctx is cancelled when the client deregisters or its lease expires.
+	// Anything running on behalf of the client should stop then.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	lastSeen time.Time
+}
+
+func (c *RegisteredClient) Context() context.Context {
+
+	return c.ctx
+}
+
+func (c *RegisteredClient) Touch() {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lastSeen = time.Now()
+}
+
+func (c *RegisteredClient) LastSeen() time.Time {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lastSeen
+}
+
+func (c *RegisteredClient) LeaseTTL() time.Duration {
+
+	return c.RegParams.GetLeaseTTL().AsDuration()
+}
+
+func (c *RegisteredClient) leaseExpired(now time.Time) bool {
+
+	ttl := c.LeaseTTL()
+	if ttl <= 0 {
+		return false
+	}
+
+	return now.Sub(c.LastSeen()) > ttl
+}
+
+// GRPC creates a different goroutine to handle each request,
+// so the registry is protected using a mutex.
+type Registry struct {
+	mu      sync.RWMutex
+	clients map[string]*RegisteredClient
+}
+
+func NewRegistry() *Registry {
+
+	return &Registry{
+		clients: make(map[string]*RegisteredClient),
+	}
+}
+
+func (r *Registry) Add(servId []byte, serviceName string,
+	regParams *pb.RegistrationParams) *RegisteredClient {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	now := time.Now()
+
+	client := &RegisteredClient{
+		ServId:       servId,
+		ServiceName:  serviceName,
+		RegParams:    regParams,
+		RegisteredAt: now,
+		ctx:          ctx,
+		cancel:       cancel,
+		lastSeen:     now,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.clients[string(servId)] = client
+
+	return client
+}
+
+// Remove takes the client out of the registry and cancels its context.
+// It returns false if the client was already removed.
+func (r *Registry) Remove(servId []byte) (*RegisteredClient, bool) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	client, ok := r.clients[string(servId)]
+	if !ok {
+		return nil, false
+	}
+
+	delete(r.clients, string(servId))
+	client.cancel()
+
+	return client, true
+}
+
+// Expired removes all clients whose lease ran out, and returns them.
+func (r *Registry) Expired(now time.Time) []*RegisteredClient {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var expired []*RegisteredClient
+	for k, c := range r.clients {
+		if c.leaseExpired(now) {
+			delete(r.clients, k)
+			c.cancel()
+			expired = append(expired, c)
+		}
+	}
+
+	return expired
+}
+
+func (r *Registry) Get(servId []byte) (*RegisteredClient, bool) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	client, ok := r.clients[string(servId)]
+	return client, ok
+}
+
+// Lookup finds the registered client that sent a message with this header.
+// Every message from a client renews its lease.
+func (r *Registry) Lookup(header *pb.Header) (*RegisteredClient, error) {
+
+	if header == nil {
+		return nil, fmt.Errorf("Error - message has no header\n")
+	}
+
+	client, ok := r.Get(header.ServId)
+	if !ok {
+		return nil, fmt.Errorf("Error - service not registered:\n\tservId: %s\n\tservType: %s\n",
+			string(header.ServId), header.SrcServType)
+	}
+	client.Touch()
+
+	return client, nil
+}
+
+func (r *Registry) Clients() []*RegisteredClient {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clients := make([]*RegisteredClient, 0, len(r.clients))
+	for _, c := range r.clients {
+		clients = append(clients, c)
+	}
+
+	return clients
+}
@@ -0,0 +1,40 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestRegistryLeaseExpiry(t *testing.T) {
+
+	registry := NewRegistry()
+
+	leased := registry.Add([]byte("leased"), "leased", &pb.RegistrationParams{
+		LeaseTTL: durationpb.New(time.Second),
+	})
+	forever := registry.Add([]byte("forever"), "forever", &pb.RegistrationParams{})
+
+	if _, err := registry.Lookup(&pb.Header{ServId: []byte("leased")}); err != nil {
+		t.Errorf("Error looking up registered client.\n\terr: %v\n", err)
+	}
+
+	expired := registry.Expired(time.Now().Add(2 * time.Second))
+	if len(expired) != 1 || expired[0] != leased {
+		t.Fatalf("Expected only the leased client to expire. Got: %v\n", expired)
+	}
+
+	if leased.Context().Err() == nil {
+		t.Errorf("Expected context of expired client to be cancelled\n")
+	}
+
+	if _, err := registry.Lookup(&pb.Header{ServId: []byte("leased")}); err == nil {
+		t.Errorf("Expected lookup of expired client to fail\n")
+	}
+
+	if _, ok := registry.Get(forever.ServId); !ok {
+		t.Errorf("Client without a lease should never expire\n")
+	}
+}
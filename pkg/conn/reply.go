@@ -0,0 +1,116 @@
+package conn
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultReplyTokenTTL = time.Minute
+	replySweepPeriod     = 10 * time.Second
+)
+
+// pendingReply is a request that was delivered to a client, and that the
+// client did not answer yet.
+type pendingReply struct {
+	servId string
+
+	// subject is where the requester waits for the reply. topic is where
+	// the request was sent. Its keys seal the reply, and its rate limits
+	// apply to it.
+	subject     string
+	topic       string
+	deliveredAt time.Time
+}
+
+// pendingReplies maps the reply tokens handed to clients to the reply
+// subjects of their requests. Clients can only respond to requests that
+// were delivered to them, instead of publishing to any subject they name.
+// Tokens the client never uses are forgotten after a while. By then the
+// requester gave up waiting.
+type pendingReplies struct {
+	mu      sync.Mutex
+	replies map[string]*pendingReply
+	swept   time.Time
+}
+
+func newPendingReplies() *pendingReplies {
+
+	return &pendingReplies{
+		replies: make(map[string]*pendingReply),
+		swept:   time.Now(),
+	}
+}
+
+// add keeps the reply subject of a request delivered to the client,
+// and returns its reply token.
+func (p *pendingReplies) add(servId, subject, topic string) string {
+
+	token := uuid.NewString()
+	now := time.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if now.Sub(p.swept) > replySweepPeriod {
+		ttl := viper.GetDuration("subscriptions.replyTokenTTL")
+		if ttl <= 0 {
+			ttl = defaultReplyTokenTTL
+		}
+
+		for t, r := range p.replies {
+			if now.Sub(r.deliveredAt) > ttl {
+				delete(p.replies, t)
+			}
+		}
+		p.swept = now
+	}
+
+	p.replies[token] = &pendingReply{
+		servId:      servId,
+		subject:     subject,
+		topic:       topic,
+		deliveredAt: now,
+	}
+
+	return token
+}
+
+// get returns the request for a token handed to the client with servId.
+func (p *pendingReplies) get(servId []byte, token string) (*pendingReply, error) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r, ok := p.replies[token]
+	if !ok || r.servId != string(servId) {
+		return nil, fmt.Errorf("Error - unknown reply token: %s\n", token)
+	}
+
+	return r, nil
+}
+
+// answered forgets a token once the client responded with it.
+func (p *pendingReplies) answered(token string) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.replies, token)
+}
+
+func (p *pendingReplies) releaseClient(servId []byte) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for t, r := range p.replies {
+		if r.servId == string(servId) {
+			delete(p.replies, t)
+		}
+	}
+}
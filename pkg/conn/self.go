@@ -19,13 +19,17 @@ func createServiceId() []byte {
 	return servId
 }
 
-func serviceId() func() []byte {
+// The sidecar ID identifies this sidecar to other sidecars,
+// so it must stay the same for the life of the process.
+var (
+	servIdOnce sync.Once
+	servId     []byte
+)
 
-	var servId []byte
+func serviceId() func() []byte {
 
 	return func() []byte {
-		var once sync.Once
-		once.Do(func() {
+		servIdOnce.Do(func() {
 			servId = createServiceId()
 		})
 
@@ -5,14 +5,21 @@ import (
 
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Server struct {
 	pb.UnimplementedSidecarServer
 
 	GrcpServer *grpc.Server
-	regParams  *pb.RegistrationParams
+	Registry   *Registry
+	Sessions   *Sessions
+	Discovery  *Discovery
+	RateLimits *RateLimits
+	Encryption *Encryption
 	Logs       *Logs
 	Pubs       *Pubs
 	Subs       *Subs
@@ -20,14 +27,15 @@ type Server struct {
 
 func (s *Server) Register(ctx context.Context, in *pb.RegistrationMsg) (*pb.RegistrationMsgResponse, error) {
 
-	// Record Registration parameters for later use
-	s.regParams = in.RegParams
-
 	// Server does assignment of message IDs.
 	in.Header.MsgId = NextMsgId()
 
 	s.Logs.logger.Log("Received RegistrationMsg: %s\n", in)
 
+	// Record Registration parameters for later use.
+	// Each client gets its own entry, keyed by the service ID we assign to it.
+	client := s.Registry.Add(createServiceId(), in.ServiceName, in.RegParams)
+
 	regRsp := &pb.RegistrationMsgResponse{
 		Header: &pb.Header{
 			MsgType:     pb.MsgType_MSG_TYPE_REG_RSP,
@@ -42,19 +50,167 @@ func (s *Server) Register(ctx context.Context, in *pb.RegistrationMsg) (*pb.Regi
 		},
 
 		Msg:            "OK",
-		AssignedServId: createServiceId(), // assign new service ID to client
+		AssignedServId: client.ServId, // assign new service ID to client
 	}
 
+	// Later calls prove they come from this client with the session token.
+	token, expiresAt := s.Sessions.Issue(client)
+	regRsp.SessionToken = token
+	regRsp.SessionExpiresAt = timestamppb.New(expiresAt)
+
 	s.Logs.logger.Log("Sending regRsp: %s\n", regRsp)
 
+	s.announce()
+
 	return regRsp, nil
 }
 
+func (s *Server) Deregister(ctx context.Context, in *pb.DeregistrationMsg) (*pb.DeregistrationMsgResponse, error) {
+
+	in.Header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received DeregistrationMsg: %s\n", in)
+
+	client, ok := s.Registry.Remove(in.Header.ServId)
+	if ok {
+		s.releaseClient(client)
+		s.announce()
+	}
+
+	deregRsp := &pb.DeregistrationMsgResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_DEREG_RSP,
+			SrcServType: serviceType(),
+			DstServType: in.Header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg: "OK",
+	}
+
+	if !ok {
+		deregRsp.RspHeader.Status = uint32(pb.Status_ERR_NOT_REGISTERED)
+		deregRsp.Msg = "Service not registered"
+	}
+
+	s.Logs.logger.Log("Sending deregRsp: %s\n", deregRsp)
+
+	return deregRsp, nil
+}
+
+func (s *Server) KeepAlive(ctx context.Context, in *pb.KeepAliveMsg) (*pb.KeepAliveMsgResponse, error) {
+
+	in.Header.MsgId = NextMsgId()
+	// Do not log keepalives to NATS. There are too many of them.
+	s.Logs.logger.PrintMsg("Received KeepAliveMsg: %s\n", in)
+
+	keepAliveRsp := &pb.KeepAliveMsgResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_KEEPALIVE_RSP,
+			SrcServType: serviceType(),
+			DstServType: in.Header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg: "OK",
+	}
+
+	// The lease is renewed by the lookup itself.
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		// Let the client know it has to register again.
+		keepAliveRsp.RspHeader.Status = uint32(pb.Status_ERR_NOT_REGISTERED)
+		keepAliveRsp.Msg = err.Error()
+		return keepAliveRsp, nil
+	}
+
+	keepAliveRsp.LeaseTTL = client.RegParams.GetLeaseTTL()
+
+	token, expiresAt := s.Sessions.Issue(client)
+	keepAliveRsp.SessionToken = token
+	keepAliveRsp.SessionExpiresAt = timestamppb.New(expiresAt)
+
+	return keepAliveRsp, nil
+}
+
+func (s *Server) announce() {
+
+	if s.Discovery != nil {
+		s.Discovery.Announce()
+	}
+}
+
+func (s *Server) Discover(ctx context.Context, in *pb.DiscoverMsg) (*pb.DiscoverMsgResponse, error) {
+
+	in.Header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received DiscoverMsg: %s\n", in)
+
+	if _, err := s.Registry.Lookup(in.Header); err != nil {
+		s.Logs.logger.Log("Error discovering: %s\n", err.Error())
+		return nil, err
+	}
+
+	// The server takes calls before discovery is set up.
+	if s.Discovery == nil {
+		s.Logs.logger.Log("Error discovering: discovery not started yet\n")
+		return nil, status.Error(codes.Unavailable, "discovery not started yet")
+	}
+
+	discoverRsp := &pb.DiscoverMsgResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_DISCOVER_RSP,
+			SrcServType: serviceType(),
+			DstServType: in.Header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		RspHeader: &pb.ResponseHeader{
+			Status: uint32(pb.Status_OK),
+		},
+
+		Msg: "OK",
+	}
+
+	instances, err := s.Discovery.Discover(ctx, in.ServiceName)
+	if err != nil {
+		s.Logs.logger.Log("Error discovering service: %s\n\terr: %s\n",
+			in.ServiceName, err.Error())
+		return nil, err
+	}
+	discoverRsp.Instances = instances
+
+	s.Logs.logger.Log("Sending DiscoverMsgResponse: %s\n", discoverRsp)
+
+	return discoverRsp, nil
+}
+
 func (s *Server) Log(ctx context.Context, in *pb.LogMsg) (*emptypb.Empty, error) {
 
 	in.Header.MsgId = NextMsgId()
 	s.Logs.logger.PrintMsg("Received LogMsg: %s\n", in)
 
+	// Only registered clients may log, within their rate limits.
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.PrintMsg("Error logging: %s\n", err.Error())
+		return nil, err
+	}
+
+	if err := s.RateLimits.Wait(ctx, client, logTopic); err != nil {
+		s.Logs.logger.PrintMsg("Error logging: %s\n", err.Error())
+		return nil, err
+	}
+
 	s.Logs.ReceivedLogMsg(in)
 
 	return &emptypb.Empty{}, nil
@@ -65,7 +221,13 @@ func (s *Server) Sub(ctx context.Context, in *pb.SubMsg) (*pb.SubMsgResponse, er
 	in.Header.MsgId = NextMsgId()
 	s.Logs.logger.Log("Received SubMsg: %s\n", in)
 
-	m, err := s.Subs.Subscribe(in)
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error subscribing: %s\n", err.Error())
+		return nil, err
+	}
+
+	m, err := s.Subs.Subscribe(client.ServId, in)
 	if err != nil {
 		s.Logs.logger.Log("Error subscribing: %s\n", err.Error())
 		return nil, err
@@ -81,7 +243,13 @@ func (s *Server) Unsub(ctx context.Context, in *pb.UnsubMsg) (*pb.UnsubMsgRespon
 	in.Header.MsgId = NextMsgId()
 	s.Logs.logger.Log("Received UnsubMsg: %s\n", in)
 
-	m, err := s.Subs.Unsubscribe(s.Logs.logger, in)
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error unsubscribing: %s\n", err.Error())
+		return nil, err
+	}
+
+	m, err := s.Subs.Unsubscribe(s.Logs.logger, client.ServId, in)
 	if err != nil {
 		s.Logs.logger.Log("Error unsubscribing: %s\n", err.Error())
 		return nil, err
@@ -98,6 +266,11 @@ func (s *Server) Recv(ctx context.Context, in *pb.Receive) (*pb.SubTopicResponse
 	// Do not log message to NATS. This creates a loop.
 	s.Logs.logger.PrintMsg("Received from NATS: %s\n", in)
 
+	if _, err := s.Registry.Lookup(in.Header); err != nil {
+		s.Logs.logger.PrintMsg("Could not receive from NATS: %s\n", err.Error())
+		return nil, err
+	}
+
 	m, err := RecvFromNATS(ctx, s, in)
 	if err != nil {
 		s.Logs.logger.Log("Could not receive from NATS: %s\n", err.Error())
@@ -109,22 +282,119 @@ func (s *Server) Recv(ctx context.Context, in *pb.Receive) (*pb.SubTopicResponse
 	return m, err
 }
 
+func (s *Server) Subscribe(in *pb.SubMsg, stream pb.Sidecar_SubscribeServer) error {
+
+	in.Header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received Subscribe: %s\n", in)
+
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error subscribing: %s\n", err.Error())
+		return err
+	}
+
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
+
+	err = StreamFromNATS(ctx, s, client.ServId, in, stream)
+	if err != nil {
+		s.Logs.logger.Log("Error streaming from NATS: %s\n", err.Error())
+	}
+
+	return err
+}
+
 func (s *Server) Pub(ctx context.Context, in *pb.PubMsg) (*pb.PubMsgResponse, error) {
 
 	in.Header.MsgId = NextMsgId()
 	s.Logs.logger.Log("Received PubMsg: %s\n", in)
 
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error publishing: %s\n", err.Error())
+		return nil, err
+	}
+
+	if err := s.RateLimits.Wait(ctx, client, in.GetTopic()); err != nil {
+		s.Logs.logger.Log("Error publishing: %s\n", err.Error())
+		return nil, err
+	}
+
 	var retryBehavior *pb.RetryBehavior
 	if in.Retry != nil {
 		retryBehavior = in.Retry
 	} else {
-		retryBehavior = s.Pubs.regParams.Retry
+		retryBehavior = client.RegParams.GetRetry()
 	}
 
-	m, err := s.Pubs.Publish(ctx, s.Logs.logger, in, retryBehavior)
+	m, err := s.Pubs.Publish(ctx, s.Logs.logger, client, in, retryBehavior)
 	if err == nil { // different logic - checking if err == nil instead of != nil
 		s.Logs.logger.Log("Sending PubMsgResponse: %s\n", m)
 	}
 
 	return m, err
 }
+
+func (s *Server) Request(ctx context.Context, in *pb.RequestMsg) (*pb.RequestMsgResponse, error) {
+
+	in.Header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received RequestMsg: %s\n", in)
+
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error sending request: %s\n", err.Error())
+		return nil, err
+	}
+
+	if err := s.RateLimits.Wait(ctx, client, in.GetTopic()); err != nil {
+		s.Logs.logger.Log("Error sending request: %s\n", err.Error())
+		return nil, err
+	}
+
+	var retryBehavior *pb.RetryBehavior
+	if in.Retry != nil {
+		retryBehavior = in.Retry
+	} else {
+		retryBehavior = client.RegParams.GetRetry()
+	}
+
+	m, err := s.Pubs.Request(ctx, s.Logs.logger, client, in, retryBehavior)
+	if err == nil {
+		s.Logs.logger.Log("Sending RequestMsgResponse: %s\n", m)
+	}
+
+	return m, err
+}
+
+func (s *Server) Respond(ctx context.Context, in *pb.RespondMsg) (*pb.RespondMsgResponse, error) {
+
+	in.Header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received RespondMsg: %s\n", in)
+
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error responding: %s\n", err.Error())
+		return nil, err
+	}
+
+	// Clients may only answer requests that were delivered to them.
+	reply, err := s.Subs.replies.get(client.ServId, in.GetReply())
+	if err != nil {
+		s.Logs.logger.Log("Error responding: %s\n", err.Error())
+		return nil, err
+	}
+
+	// Replies count against the limits of the topic the request came in on.
+	if err := s.RateLimits.Wait(ctx, client, reply.topic); err != nil {
+		s.Logs.logger.Log("Error responding: %s\n", err.Error())
+		return nil, err
+	}
+
+	m, err := s.Pubs.Respond(ctx, s.Logs.logger, in, reply)
+	if err == nil {
+		s.Subs.replies.answered(in.GetReply())
+		s.Logs.logger.Log("Sending RespondMsgResponse: %s\n", m)
+	}
+
+	return m, err
+}
@@ -4,61 +4,282 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
 
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
 	"google.golang.org/protobuf/proto"
 	"github.com/spf13/viper"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func (s *Server) DocDownloadStream(stream pb.Sidecar_DocDownloadStreamServer) error {
 
-	return s.Subs.DownloadJS(stream)
-}
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
 
-func (s *Server) pubNATS(topic, name string, in *pb.DocUpload) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("Error receiving from document download stream: %w", err)
+	}
+
+	client, b, err := s.openDocStream(first.GetOpen())
+	if err != nil {
+		s.Logs.logger.Log("Error opening document download stream: %s\n", err.Error())
+		return err
+	}
 
-	js := s.Pubs.natsConn.js
+	return s.Subs.DownloadJS(ctx, client.ServId, b, first.GetControl().GetFlow(), stream)
+}
 
-	fmt.Printf("PubNATS: entered\n")
-	defer fmt.Printf("pubNATS: exiting\n")
+// openDocStream looks up the client opening a document stream,
+// and returns what the stream is bound to.
+func (s *Server) openDocStream(open *pb.DocStreamOpen) (*RegisteredClient, docStreamBinding, error) {
 
-	s.Logs.logger.Log("PubNATS: %s\n", in)
+	if open == nil {
+		return nil, docStreamBinding{}, fmt.Errorf("Error - first message on document stream has no DocStreamOpen\n")
+	}
 
-	bs, err := proto.Marshal(in)
+	client, err := s.Registry.Lookup(open.Header)
 	if err != nil {
-		return fmt.Errorf("Error marshalling upload document: %w", err)
+		return nil, docStreamBinding{}, err
 	}
 
-	fmt.Printf("PubNATS: publishing\n")
-	future, err := js.PublishAsync(topic, bs)
+	b := newDocStreamBinding(open)
+
+	// The stream in the sidecar-config is set up on first use.
+	if b.stream == viper.GetString("nats.jetstream.name") {
+		if _, err = NewNATSConnJS(s.Subs.natsConn); err != nil {
+			return nil, docStreamBinding{}, err
+		}
+	}
+
+	s.Logs.logger.Log("Opened document stream: %s\n", b)
+
+	return client, b, nil
+}
+
+// pubNATS publishes a message from an upload stream to its subject.
+func (s *Server) pubNATS(b docStreamBinding, m *nats.Msg) (nats.PubAckFuture, error) {
+
+	js, err := s.Pubs.natsConn.JetStream()
 	if err != nil {
-		return fmt.Errorf("Error publishing to JetStream with topic: %s\n",
-			topic)
+		return nil, err
+	}
+
+	m.Subject = b.subject
+	if err = s.Encryption.sealMsg(m); err != nil {
+		return nil, err
 	}
-	s.Logs.logger.Log("Published to JetStream - future returned: %v\n", future)
+	s.Logs.logger.Log("PubNATS: subject: %s header: %v length: %d\n", m.Subject, m.Header, len(m.Data))
 
-	fmt.Printf("PubNATS: published\n")
+	future, err := js.PublishMsgAsync(m, nats.ExpectStream(b.stream))
+	if err != nil {
+		return nil, fmt.Errorf("Error publishing to JetStream with topic: %s\n\terr: %w",
+			b.subject, err)
+	}
 
-	return nil
+	return future, nil
 }
 
 func (s *Server) DocUploadStream(stream pb.Sidecar_DocUploadStreamServer) error {
 
-	ctx := stream.Context()
+	return s.uploadJS(stream, func() (*upload, error) {
+
+		docUpload, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
 
-	topic := viper.GetString("nats.jetstream.subject")
-	name := viper.GetString("nats.jetstream.name")
+		u := &upload{
+			open:      docUpload.GetOpen(),
+			msgNumber: docUpload.GetMsgNumber(),
+		}
 
-	var err error
+		if docUpload.GetDocuments() == nil {
+			return u, nil
+		}
 
-	var docUpload *pb.DocUpload
+		// Downloads read the documents back as a DocDownload.
+		bs, err := proto.Marshal(&pb.DocUpload{
+			Documents: docUpload.Documents,
+			MsgNumber: docUpload.MsgNumber,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error marshalling upload document: %w", err)
+		}
+		key := docUpload.GetIdempotencyKey()
+		if key == "" {
+			key = docsIdempotencyKey(docUpload.Documents)
+		}
+		u.msg = withMsgId(&nats.Msg{Data: bs}, key)
 
-	err = s.ThrottleGRPCSender(ctx, stream)
+		return u, nil
+	})
+}
+
+func (s *Server) PayloadUploadStream(stream pb.Sidecar_PayloadUploadStreamServer) error {
+
+	return s.uploadJS(stream, func() (*upload, error) {
+
+		payloadUpload, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+
+		u := &upload{
+			open:      payloadUpload.GetOpen(),
+			msgNumber: payloadUpload.GetMsgNumber(),
+		}
+
+		if payloadUpload.GetPayload() != nil {
+			u.msg = payloadMsg("", payloadUpload.GetPayload())
+		}
+
+		return u, nil
+	})
+}
+
+func (s *Server) PayloadDownloadStream(stream pb.Sidecar_PayloadDownloadStreamServer) error {
+
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("Error receiving from payload download stream: %w", err)
+	}
+
+	client, b, err := s.openDocStream(first.GetOpen())
+	if err != nil {
+		s.Logs.logger.Log("Error opening payload download stream: %s\n", err.Error())
+		return err
+	}
+
+	return s.Subs.DownloadPayloadsJS(ctx, client.ServId, b, first.GetControl().GetFlow(), stream)
+}
+
+type uploadStream interface {
+	uploadControl
+	Context() context.Context
+}
+
+// upload is a message from the client on an upload stream. Only the first
+// one opens the stream, and msg is nil if there is nothing to publish.
+type upload struct {
+	open      *pb.DocStreamOpen
+	msgNumber uint64
+	msg       *nats.Msg
+}
+
+// pendingUpload is a message that JetStream did not confirm yet.
+type pendingUpload struct {
+	msgNumber uint64
+	future    nats.PubAckFuture
+	err       error
+}
+
+// lockedUploadControl lets flow control and publish results share the stream.
+type lockedUploadControl struct {
+	mu     sync.Mutex
+	stream uploadControl
+}
+
+func (l *lockedUploadControl) Send(rsp *pb.DocUploadResponse) error {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.stream.Send(rsp)
+}
+
+// reportUploads tells the client what became of each message it uploaded,
+// in the order it uploaded them. It returns how many may not have been
+// stored, once pending is closed and every message in it was reported.
+func reportUploads(control uploadControl, pending <-chan pendingUpload) <-chan uint64 {
+
+	failed := make(chan uint64, 1)
+
+	go func() {
+		var numFailed uint64
+
+		for p := range pending {
+			rsp := &pb.DocUploadResponse{AckMsgNumber: p.msgNumber}
+
+			err := p.err
+			if err == nil {
+				var ack *nats.PubAck
+				if ack, err = awaitPubAck(p.future); err == nil {
+					rsp.PubAck = pbPubAck(ack)
+				}
+			}
+			if err != nil {
+				numFailed++
+				rsp.Error = err.Error()
+			}
+
+			// The client may be gone. Keep going anyway,
+			// to count what failed.
+			control.Send(rsp)
+		}
+
+		failed <- numFailed
+	}()
+
+	return failed
+}
+
+// uploadJS publishes what the client sends on an upload stream, until the
+// client ends it. recv returns the next message from the client. The stream
+// ends once JetStream stored every message, or failed to.
+func (s *Server) uploadJS(stream uploadStream, recv func() (*upload, error)) error {
+
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
+
+	u, err := recv()
+	if err != nil {
+		return fmt.Errorf("Error receiving from stream on server: %w", err)
+	}
+
+	_, b, err := s.openDocStream(u.open)
+	if err != nil {
+		s.Logs.logger.Log("Error opening upload stream: %s\n", err.Error())
+		return err
+	}
+
+	control := &lockedUploadControl{stream: stream}
+
+	err = s.ThrottleGRPCSender(ctx, control, b)
 	if err != nil {
 		return fmt.Errorf("Error starting goroutine uploadDocsThrottle: %w", err)
 	}
 
+	pending := make(chan pendingUpload, msgChSize)
+	failed := reportUploads(control, pending)
+
+	// However the stream ends, wait until every result was reported, so
+	// that nothing is sent on the stream once this handler returned.
+	var finished sync.Once
+	var numFailed uint64
+	finish := func() uint64 {
+		finished.Do(func() {
+			close(pending)
+			numFailed = <-failed
+		})
+		return numFailed
+	}
+	defer finish()
+
+	publish := func(u *upload) {
+		future, err := s.pubNATS(b, u.msg)
+		pending <- pendingUpload{msgNumber: u.msgNumber, future: future, err: err}
+	}
+
+	// The opening message may carry something to publish too.
+	if u.msg != nil {
+		publish(u)
+	}
+
 LOOP2:
 	for {
 		select {
@@ -69,15 +290,18 @@ LOOP2:
 					ctx.Err())
 			}
 
-			unsubscribeJS(s.Subs, s.Subs.currentStreamTopic)
-
 			// Wait until client removes messages from channel
 			// time.Sleep(10 * time.Second)
 			break LOOP2
 		default:
-			docUpload, err = stream.Recv()
+			u, err = recv()
 			if err == io.EOF {
 				fmt.Printf("DocUploadStream: Stream ended\n")
+
+				if numFailed := finish(); numFailed > 0 {
+					return fmt.Errorf("Error - %d messages on the upload stream may not have been stored\n",
+						numFailed)
+				}
 				return nil
 			}
 
@@ -87,8 +311,10 @@ LOOP2:
 			}
 
 			fmt.Printf(">")
-			// Send document to NATS server
-			s.pubNATS(topic, name, docUpload)
+			// Send to NATS server
+			if u.msg != nil {
+				publish(u)
+			}
 		}
 	}
 
@@ -100,15 +326,20 @@ func (s *Server) AddJS(ctx context.Context, in *pb.AddJSMsg) (*pb.AddJSMsgRespon
 	in.Header.MsgId = NextMsgId()
 	s.Logs.logger.Log("Received AddJSMsg: %s\n", in)
 
-	var err error
-	s.Subs.natsConn.js, err = NewNATSConnJS(s.Subs.natsConn.nc)
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error subscribing: %s\n", err.Error())
+		return nil, err
+	}
+
+	_, err = NewNATSConnJS(s.Subs.natsConn)
 	if err != nil {
 
 		return nil, fmt.Errorf("Error adding Jetstream: %w\n",
 			err)
 	}
 
-	m, err := s.Subs.AddJS(ctx, in)
+	m, err := s.Subs.AddJS(ctx, client.ServId, in)
 	if err != nil {
 		s.Logs.logger.Log("Error subscribing: %s\n", err.Error())
 		return nil, err
@@ -125,7 +356,13 @@ func (s *Server) UnsubJS(ctx context.Context, in *pb.UnsubJSMsg) (*pb.UnsubJSMsg
 	in.Header.MsgId = NextMsgId()
 	s.Logs.logger.Log("Received UnsubJSMsg: %s\n", in)
 
-	m, err := s.Subs.UnsubscribeJS(s.Logs.logger, in)
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error unsubscribing: %s\n", err.Error())
+		return nil, err
+	}
+
+	m, err := s.Subs.UnsubscribeJS(s.Logs.logger, client.ServId, in)
 	if err != nil {
 		s.Logs.logger.Log("Error unsubscribing: %s\n", err.Error())
 		return nil, err
@@ -136,16 +373,91 @@ func (s *Server) UnsubJS(ctx context.Context, in *pb.UnsubJSMsg) (*pb.UnsubJSMsg
 	return m, err
 }
 
-func (s *Server) PubJS(ctx context.Context, in *pb.PubJSMsg) (*emptypb.Empty, error) {
+func (s *Server) PubJS(ctx context.Context, in *pb.PubJSMsg) (*pb.PubJSMsgResponse, error) {
+
+	in.Header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received PubJSMsg: %s\n", in)
+
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error publishing: %s\n", err.Error())
+		return nil, err
+	}
+
+	if err := s.RateLimits.Wait(ctx, client, in.GetTopic()); err != nil {
+		s.Logs.logger.Log("Error publishing: %s\n", err.Error())
+		return nil, err
+	}
+
+	m, err := s.Pubs.PublishJS(ctx, client.ServId, in)
+	if err != nil {
+		s.Logs.logger.Log("Error publishing: %s\n", err.Error())
+		return nil, err
+	}
+	s.Logs.logger.Log("Sending PubJSMsgResponse: %s\n", m)
+
+	return m, nil
+}
+
+func (s *Server) PubAcks(in *pb.PubAcksMsg, stream pb.Sidecar_PubAcksServer) error {
 
 	in.Header.MsgId = NextMsgId()
-	s.Logs.logger.Log("Received PubMsg: %s\n", in)
+	s.Logs.logger.Log("Received PubAcksMsg: %s\n", in)
 
-	future, err := s.Pubs.natsConn.js.PublishAsync(in.Topic, in.Msg)
+	client, err := s.Registry.Lookup(in.Header)
 	if err != nil {
-		return &emptypb.Empty{}, fmt.Errorf("Error publishing to JetStream with topic: %s\n", in.Topic)
+		s.Logs.logger.Log("Error streaming pub acks: %s\n", err.Error())
+		return err
+	}
+
+	ctx, cancel := s.streamContext(stream.Context())
+	defer cancel()
+
+	err = s.Pubs.StreamPubAcks(ctx, client.ServId, in, stream)
+	if err != nil {
+		s.Logs.logger.Log("Error streaming pub acks: %s\n", err.Error())
+	}
+
+	return err
+}
+
+func (s *Server) Ack(ctx context.Context, in *pb.AckMsg) (*pb.AckMsgResponse, error) {
+
+	return s.settle(ctx, in, ackAck)
+}
+
+func (s *Server) Nak(ctx context.Context, in *pb.AckMsg) (*pb.AckMsgResponse, error) {
+
+	return s.settle(ctx, in, ackNak)
+}
+
+func (s *Server) InProgress(ctx context.Context, in *pb.AckMsg) (*pb.AckMsgResponse, error) {
+
+	return s.settle(ctx, in, ackInProgress)
+}
+
+func (s *Server) Term(ctx context.Context, in *pb.AckMsg) (*pb.AckMsgResponse, error) {
+
+	return s.settle(ctx, in, ackTerm)
+}
+
+func (s *Server) settle(ctx context.Context, in *pb.AckMsg, kind ackKind) (*pb.AckMsgResponse, error) {
+
+	in.Header.MsgId = NextMsgId()
+	s.Logs.logger.Log("Received %s: %s\n", kind, in)
+
+	client, err := s.Registry.Lookup(in.Header)
+	if err != nil {
+		s.Logs.logger.Log("Error settling message: %s\n", err.Error())
+		return nil, err
+	}
+
+	m, err := s.Subs.Settle(ctx, s.Logs.logger, client.ServId, in, kind)
+	if err != nil {
+		s.Logs.logger.Log("Error settling message: %s\n", err.Error())
+		return nil, err
 	}
-	s.Logs.logger.Log("Published to JetStream - future returned: %v\n", future)
+	s.Logs.logger.Log("Sending AckMsgResponse: %s\n", m)
 
-	return &emptypb.Empty{}, err
+	return m, nil
 }
@@ -0,0 +1,232 @@
+package conn
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSessionTTL = time.Hour
+
+	authMetadataKey = "authorization"
+	bearerPrefix    = "Bearer "
+
+	// Register is the only call made without a session.
+	registerMethod = "/messages.Sidecar/Register"
+)
+
+type sessionClaims struct {
+	ServId      []byte `json:"servId"`
+	ServiceName string `json:"serviceName"`
+	ExpiresAt   int64  `json:"expiresAt"`
+}
+
+// Sessions issues the session tokens clients get from Register, and checks
+// them on every later call. A token is its claims in JSON and their
+// HMAC-SHA256, both base64url encoded, with a dot in between.
+//
+// The key is made up at startup. The registry does not outlive the sidecar
+// either, so clients have to register again after a restart anyway.
+type Sessions struct {
+	key []byte
+	ttl time.Duration
+}
+
+func NewSessions() (*Sessions, error) {
+
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("Error generating session key\n\terr: %w", err)
+	}
+
+	ttl := viper.GetDuration("sessionTTL")
+	if ttl <= 0 {
+		ttl = defaultSessionTTL
+	}
+
+	return &Sessions{
+		key: key,
+		ttl: ttl,
+	}, nil
+}
+
+func (s *Sessions) sign(payload []byte) []byte {
+
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// Issue returns a new session token for the client, and when it expires.
+func (s *Sessions) Issue(client *RegisteredClient) (string, time.Time) {
+
+	expiresAt := time.Now().Add(s.ttl)
+
+	// Marshalling a struct of bytes, strings and ints cannot fail.
+	payload, _ := json.Marshal(&sessionClaims{
+		ServId:      client.ServId,
+		ServiceName: client.ServiceName,
+		ExpiresAt:   expiresAt.Unix(),
+	})
+
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(s.sign(payload)), expiresAt
+}
+
+func (s *Sessions) Verify(token string, now time.Time) (*sessionClaims, error) {
+
+	enc := base64.RawURLEncoding
+
+	encPayload, encSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf("malformed session token")
+	}
+
+	payload, err := enc.DecodeString(encPayload)
+	if err != nil {
+		return nil, fmt.Errorf("malformed session token")
+	}
+
+	sig, err := enc.DecodeString(encSig)
+	if err != nil || !hmac.Equal(sig, s.sign(payload)) {
+		return nil, fmt.Errorf("invalid session token")
+	}
+
+	var claims sessionClaims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("malformed session token")
+	}
+
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, fmt.Errorf("session token expired")
+	}
+
+	return &claims, nil
+}
+
+// authenticate returns the claims of the session token the call came with.
+func (s *Sessions) authenticate(ctx context.Context) (*sessionClaims, error) {
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	auth := md.Get(authMetadataKey)
+	if len(auth) == 0 || !strings.HasPrefix(auth[0], bearerPrefix) {
+		return nil, status.Error(codes.Unauthenticated, "no session token - register first")
+	}
+
+	claims, err := s.Verify(strings.TrimPrefix(auth[0], bearerPrefix), time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// Streams may name the client in their metadata too.
+	if servIds := md.Get(servIdMetadataKey); len(servIds) > 0 && servIds[0] != string(claims.ServId) {
+		return nil, status.Error(codes.PermissionDenied, "servid metadata does not match session")
+	}
+
+	return claims, nil
+}
+
+// check rejects a message sent on behalf of some other client.
+func (c *sessionClaims) check(header *pb.Header) error {
+
+	if !bytes.Equal(header.GetServId(), c.ServId) || header.GetSrcServType() != c.ServiceName {
+		return status.Errorf(codes.PermissionDenied,
+			"header does not match session:\n\tservId: %s\n\tservType: %s",
+			string(header.GetServId()), header.GetSrcServType())
+	}
+
+	return nil
+}
+
+type headerMsg interface {
+	GetHeader() *pb.Header
+}
+
+// Document and payload streams carry the header in their first message only.
+type openMsg interface {
+	GetOpen() *pb.DocStreamOpen
+}
+
+func msgHeader(m interface{}) *pb.Header {
+
+	switch m := m.(type) {
+	case headerMsg:
+		return m.GetHeader()
+	case openMsg:
+		return m.GetOpen().GetHeader()
+	}
+
+	return nil
+}
+
+func (s *Server) authUnary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+
+	if info.FullMethod == registerMethod {
+		return handler(ctx, req)
+	}
+
+	claims, err := s.Sessions.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header := msgHeader(req)
+	if header == nil {
+		return nil, status.Error(codes.InvalidArgument, "message has no header")
+	}
+
+	if err = claims.check(header); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (s *Server) authStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	claims, err := s.Sessions.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authServerStream{ss, claims})
+}
+
+// authServerStream checks the header of every message received on a stream
+// that has one.
+type authServerStream struct {
+	grpc.ServerStream
+	claims *sessionClaims
+}
+
+func (a *authServerStream) RecvMsg(m interface{}) error {
+
+	if err := a.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if header := msgHeader(m); header != nil {
+		return a.claims.check(header)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package conn
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSessionTokens(t *testing.T) {
+
+	sessions, err := NewSessions()
+	if err != nil {
+		t.Fatalf("Error creating sessions\n\terr: %v\n", err)
+	}
+
+	client := &RegisteredClient{ServId: []byte("servId"), ServiceName: "testing"}
+	token, expiresAt := sessions.Issue(client)
+
+	claims, err := sessions.Verify(token, time.Now())
+	if err != nil {
+		t.Fatalf("Error verifying session token\n\terr: %v\n", err)
+	}
+	if string(claims.ServId) != "servId" || claims.ServiceName != "testing" {
+		t.Errorf("Session token claims wrong client. Got: %+v\n", claims)
+	}
+
+	if _, err = sessions.Verify(token, expiresAt.Add(time.Second)); err == nil {
+		t.Errorf("Expected an expired session token to be rejected\n")
+	}
+
+	// Flip a character in the claims.
+	tampered := []byte(token)
+	tampered[2] ^= 1
+	if _, err = sessions.Verify(string(tampered), time.Now()); err == nil {
+		t.Errorf("Expected a tampered session token to be rejected\n")
+	}
+
+	other, _ := NewSessions()
+	if _, err = other.Verify(token, time.Now()); err == nil {
+		t.Errorf("Expected a token signed with another key to be rejected\n")
+	}
+}
+
+func TestAuthUnary(t *testing.T) {
+
+	sessions, _ := NewSessions()
+	srv := &Server{Sessions: sessions}
+
+	token, _ := sessions.Issue(&RegisteredClient{ServId: []byte("servId"), ServiceName: "testing"})
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	call := func(method, token string, header *pb.Header) codes.Code {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authMetadataKey, bearerPrefix+token))
+		}
+
+		_, err := srv.authUnary(ctx, &pb.PubMsg{Header: header},
+			&grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return status.Code(err)
+	}
+
+	own := &pb.Header{ServId: []byte("servId"), SrcServType: "testing"}
+	pub := "/messages.Sidecar/Pub"
+
+	if code := call(registerMethod, "", own); code != codes.OK {
+		t.Errorf("Expected Register to need no session. Got: %s\n", code)
+	}
+
+	if code := call(pub, "", own); code != codes.Unauthenticated {
+		t.Errorf("Expected a call without a session to be unauthenticated. Got: %s\n", code)
+	}
+
+	if code := call(pub, token, own); code != codes.OK {
+		t.Errorf("Expected a call with its own header to go through. Got: %s\n", code)
+	}
+
+	if code := call(pub, token, &pb.Header{ServId: []byte("other"), SrcServType: "testing"}); code != codes.PermissionDenied {
+		t.Errorf("Expected a call with another ServId to be denied. Got: %s\n", code)
+	}
+
+	if code := call(pub, token, &pb.Header{ServId: []byte("servId"), SrcServType: "other"}); code != codes.PermissionDenied {
+		t.Errorf("Expected a call with another SrcServType to be denied. Got: %s\n", code)
+	}
+}
+
+// testServerStream receives msg, and nothing else.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	msg proto.Message
+}
+
+func (ss *testServerStream) Context() context.Context {
+
+	return ss.ctx
+}
+
+func (ss *testServerStream) RecvMsg(m interface{}) error {
+
+	proto.Merge(m.(proto.Message), ss.msg)
+	return nil
+}
+
+func TestAuthStream(t *testing.T) {
+
+	sessions, _ := NewSessions()
+	srv := &Server{Sessions: sessions}
+
+	token, _ := sessions.Issue(&RegisteredClient{ServId: []byte("servId"), ServiceName: "testing"})
+	own := &pb.Header{ServId: []byte("servId"), SrcServType: "testing"}
+	other := &pb.Header{ServId: []byte("other"), SrcServType: "testing"}
+
+	// The handlers read the first message, which opens the stream.
+	subscribe := func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&pb.SubMsg{})
+	}
+	upload := func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&pb.DocUpload{})
+	}
+
+	call := func(token string, handler grpc.StreamHandler, first proto.Message) codes.Code {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authMetadataKey, bearerPrefix+token))
+		}
+
+		err := srv.authStream(nil, &testServerStream{ctx: ctx, msg: first},
+			&grpc.StreamServerInfo{}, handler)
+		return status.Code(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		handler grpc.StreamHandler
+		first   proto.Message
+		code    codes.Code
+	}{
+		{"subscribe", token, subscribe, &pb.SubMsg{Header: own}, codes.OK},
+		{"subscribe without a token", "", subscribe, &pb.SubMsg{Header: own}, codes.Unauthenticated},
+		{"subscribe with an invalid token", "bad." + token, subscribe, &pb.SubMsg{Header: own}, codes.Unauthenticated},
+		{"subscribe as another client", token, subscribe, &pb.SubMsg{Header: other}, codes.PermissionDenied},
+		{"upload", token, upload, &pb.DocUpload{Open: &pb.DocStreamOpen{Header: own}}, codes.OK},
+		{"upload without a token", "", upload, &pb.DocUpload{Open: &pb.DocStreamOpen{Header: own}}, codes.Unauthenticated},
+		{"upload with an invalid token", token + "x", upload, &pb.DocUpload{Open: &pb.DocStreamOpen{Header: own}}, codes.Unauthenticated},
+		{"upload as another client", token, upload, &pb.DocUpload{Open: &pb.DocStreamOpen{Header: other}}, codes.PermissionDenied},
+		{"upload without an open", token, upload, &pb.DocUpload{MsgNumber: 2}, codes.OK},
+	}
+
+	for _, test := range tests {
+		if code := call(test.token, test.handler, test.first); code != test.code {
+			t.Errorf("%s: expected: %s Got: %s\n", test.name, test.code, code)
+		}
+	}
+
+	// Streams may name the client in their metadata too.
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.Pairs(authMetadataKey, bearerPrefix+token, servIdMetadataKey, "other"))
+	err := srv.authStream(nil, &testServerStream{ctx: ctx, msg: &pb.SubMsg{Header: own}},
+		&grpc.StreamServerInfo{}, subscribe)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("Expected servid metadata of another client to be denied. Got: %v\n", err)
+	}
+}
@@ -2,15 +2,23 @@ package conn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/find-in-docs/sidecar/pkg/utils"
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
 	"github.com/spf13/viper"
 )
 
-func (s *Server) StreamFlowControl(stream pb.Sidecar_DocUploadStreamServer,
+// uploadControl is the server side of an upload stream,
+// on which the sidecar says when to stop and start sending.
+type uploadControl interface {
+	Send(*pb.DocUploadResponse) error
+}
+
+func (s *Server) StreamFlowControl(stream uploadControl,
 	unprocessedMsgs uint64) {
 
 	thresholdOFF := viper.GetUint64("nats.jetstream.thresholdOFF")
@@ -37,8 +45,10 @@ func (s *Server) StreamFlowControl(stream pb.Sidecar_DocUploadStreamServer,
 	}
 }
 
+// ThrottleGRPCSender turns the upload on and off, as the backlog of the
+// consumer bound to the upload grows and shrinks.
 func (s *Server) ThrottleGRPCSender(ctx context.Context,
-	stream pb.Sidecar_DocUploadStreamServer) error {
+	stream uploadControl, b docStreamBinding) error {
 
 	var err error
 
@@ -47,9 +57,6 @@ func (s *Server) ThrottleGRPCSender(ctx context.Context,
 		return fmt.Errorf("Error Parsing Jetstream throttle check period: %w", err)
 	}
 
-	jsName := viper.GetString("nats.jetstream.name")
-	cName := viper.GetString("nats.jetstream.consumer.durableName")
-
 	utils.StartGoroutine("uploadDocsClientRecv", func() {
 	LOOP:
 		for {
@@ -61,13 +68,23 @@ func (s *Server) ThrottleGRPCSender(ctx context.Context,
 				break LOOP
 			case <-time.After(ns):
 
-				cInfo, err := s.Pubs.natsConn.js.ConsumerInfo(jsName, cName)
+				js, err := s.Pubs.natsConn.JetStream()
 				if err != nil {
 					break
 				}
 
-				// fmt.Printf("c.NumPending: %d\n", cInfo.NumPending)
-				s.StreamFlowControl(stream, cInfo.NumPending)
+				// Until a download creates the consumer,
+				// nobody is falling behind.
+				var numPending uint64
+				cInfo, err := js.ConsumerInfo(b.stream, b.durableName)
+				if err == nil {
+					numPending = cInfo.NumPending
+				} else if !errors.Is(err, nats.ErrConsumerNotFound) {
+					break
+				}
+
+				// fmt.Printf("c.NumPending: %d\n", numPending)
+				s.StreamFlowControl(stream, numPending)
 			}
 		}
 	})
@@ -2,8 +2,10 @@ package conn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"sync/atomic"
 	"time"
 
 	"github.com/find-in-docs/sidecar/pkg/log"
@@ -14,22 +16,164 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-func unsubscribeJS(subs *Subs, topic string) {
-	subs.subscriptionsJS[topic].Drain()
-	subs.subscriptionsJS[topic].Unsubscribe()
-	delete(subs.subscriptionsJS, topic)
+func unsubscribeJS(subs *Subs, key jsSubKey) {
 
-	// close(subs.natsJSMsgs[topic])
-	// delete(subs.natsJSMsgs, topic)
+	subs.mu.Lock()
+	defer subs.mu.Unlock()
+
+	subs.unsubscribeJSLocked(key)
+}
+
+// unsubscribeJSLocked must be called with subs.mu held.
+func (subs *Subs) unsubscribeJSLocked(key jsSubKey) {
+
+	// The subscription may already be gone if its client deregistered.
+	subscription, ok := subs.subscriptionsJS[key]
+	if !ok {
+		return
+	}
+
+	subscription.Drain()
+	subscription.Unsubscribe()
+	delete(subs.subscriptionsJS, key)
+}
+
+// docStreamBinding is where a document stream publishes to or fetches from,
+// with the sidecar-config filling in whatever the client left out.
+type docStreamBinding struct {
+	subject     string
+	stream      string
+	durableName string
+}
+
+func newDocStreamBinding(open *pb.DocStreamOpen) docStreamBinding {
+
+	b := docStreamBinding{
+		subject:     open.GetSubject(),
+		stream:      open.GetStream(),
+		durableName: open.GetDurableName(),
+	}
+
+	if b.subject == "" {
+		b.subject = viper.GetString("nats.jetstream.subject")
+	}
+	if b.stream == "" {
+		b.stream = viper.GetString("nats.jetstream.name")
+	}
+	if b.durableName == "" {
+		b.durableName = viper.GetString("nats.jetstream.consumer.durableName")
+	}
+
+	return b
+}
+
+func (b docStreamBinding) String() string {
+
+	return fmt.Sprintf("subject: %s stream: %s durableName: %s", b.subject, b.stream, b.durableName)
+}
+
+// pullSubscribeJS binds a pull subscription to the durable consumer, and
+// creates the consumer first if needed. NATS deletes consumers it creates
+// for a subscription once the subscription ends, which would pull the
+// consumer out from under any other subscription bound to it.
+func (c *Conn) pullSubscribeJS(b docStreamBinding) (*nats.Subscription, error) {
+
+	js, err := c.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = js.ConsumerInfo(b.stream, b.durableName)
+	if errors.Is(err, nats.ErrConsumerNotFound) {
+		_, err = js.AddConsumer(b.stream, &nats.ConsumerConfig{
+			Durable:       b.durableName,
+			FilterSubject: b.subject,
+			AckPolicy:     nats.AckExplicitPolicy,
+			DeliverPolicy: nats.DeliverAllPolicy,
+		})
+		if errors.Is(err, nats.ErrConsumerNameAlreadyInUse) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error - could not find or add consumer: %s on stream: %s\n\terr: %w",
+			b.durableName, b.stream, err)
+	}
+
+	subscription, err := js.PullSubscribe(b.subject, b.durableName,
+		nats.Bind(b.stream, b.durableName),
+		nats.ManualAck(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Could not subscribe: %s: %w", b, err)
+	}
+
+	return subscription, nil
+}
+
+// errBadMsg is returned by the send function of a download, for a message
+// it cannot make sense of. Delivering it again will not help, so it is
+// terminated instead.
+var errBadMsg = errors.New("Error - could not decode message")
+
+// DownloadJS sends the documents fetched from the bound consumer to the
+// stream. Each stream has a subscription of its own, which ends with it.
+// Streams bound to the same consumer share its documents between them.
+// Documents are sent while flow, from the message that opened the stream,
+// and then from the control messages after it, is ON.
+func (subs *Subs) DownloadJS(ctx context.Context, servId []byte, b docStreamBinding,
+	flow pb.StreamFlow, stream pb.Sidecar_DocDownloadStreamServer) error {
+
+	return subs.downloadJS(ctx, servId, b, flow, stream, func(m *nats.Msg, ackToken string) error {
+
+		var docDownload pb.DocDownload
+		if err := proto.Unmarshal(m.Data, &docDownload); err != nil {
+			return fmt.Errorf("%w: %v", errBadMsg, err)
+		}
+
+		return stream.Send(&pb.DocDownload{
+			Documents: docDownload.Documents,
+			MsgNumber: docDownload.MsgNumber,
+			AckToken:  ackToken,
+			Headers:   pbHeaders(m.Header),
+		})
+	})
 }
 
-func (subs *Subs) DownloadJS(stream pb.Sidecar_DocDownloadStreamServer) error {
+// DownloadPayloadsJS is DownloadJS for any payload.
+func (subs *Subs) DownloadPayloadsJS(ctx context.Context, servId []byte, b docStreamBinding,
+	flow pb.StreamFlow, stream pb.Sidecar_PayloadDownloadStreamServer) error {
 
-	ctx := stream.Context()
+	return subs.downloadJS(ctx, servId, b, flow, stream, func(m *nats.Msg, ackToken string) error {
+
+		meta, err := m.Metadata()
+		if err != nil {
+			return fmt.Errorf("%w: %v", errBadMsg, err)
+		}
+
+		return stream.Send(&pb.PayloadDownload{
+			Payload:  msgPayload(m),
+			Subject:  m.Subject,
+			Sequence: meta.Sequence.Stream,
+			AckToken: ackToken,
+		})
+	})
+}
+
+// downloadControl is the server side of a download stream,
+// on which the client says when to stop and start sending.
+type downloadControl interface {
+	Recv() (*pb.DocDownloadResponse, error)
+}
+
+func (subs *Subs) downloadJS(ctx context.Context, servId []byte, b docStreamBinding,
+	first pb.StreamFlow, control downloadControl, send func(m *nats.Msg, ackToken string) error) error {
 
 	var err error
 
-	var flow pb.StreamFlow
+	// Set by the goroutine reading control messages, and read here.
+	var flow atomic.Int32
+	flow.Store(int32(first))
 
 	fmt.Printf("In DownloadJS\n")
 	defer fmt.Printf("Exiting DownloadJS\n")
@@ -40,11 +184,11 @@ func (subs *Subs) DownloadJS(stream pb.Sidecar_DocDownloadStreamServer) error {
 			select {
 			case <-ctx.Done():
 				if ctx.Err() != nil {
-					fmt.Errorf("Done channel signaled: %v\n", err)
+					fmt.Printf("Done channel signaled: %v\n", err)
 				}
 				break LOOP
 			default:
-				response, err := stream.Recv()
+				response, err := control.Recv()
 				if err == io.EOF {
 					fmt.Printf("Document download stream ended.\n")
 					break LOOP
@@ -54,8 +198,9 @@ func (subs *Subs) DownloadJS(stream pb.Sidecar_DocDownloadStreamServer) error {
 					break LOOP
 				}
 
-				flow = response.Control.Flow
-				if flow == pb.StreamFlow_ON {
+				f := response.GetControl().GetFlow()
+				flow.Store(int32(f))
+				if f == pb.StreamFlow_ON {
 					fmt.Printf("^")
 				} else {
 					fmt.Printf("v")
@@ -82,8 +227,13 @@ func (subs *Subs) DownloadJS(stream pb.Sidecar_DocDownloadStreamServer) error {
 	}
 	natsMaxWait := nats.MaxWait(maxWait)
 
-	topic := subs.currentStreamTopic
-	subscription := subs.subscriptionsJS[topic]
+	subscription, err := subs.natsConn.pullSubscribeJS(b)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+
+	topic := b.subject
 	fmt.Printf("topic: %s\n", topic)
 
 LOOP:
@@ -96,15 +246,22 @@ LOOP:
 					ctx.Err())
 			}
 
-			unsubscribeJS(subs, subs.currentStreamTopic)
 			break LOOP
 		default:
-			for flow == pb.StreamFlow_OFF {
+			for pb.StreamFlow(flow.Load()) == pb.StreamFlow_OFF && ctx.Err() == nil {
 				time.Sleep(flowControlTimeoutInNs)
 			}
+
+			// The stream may have ended while flow was OFF.
+			if ctx.Err() != nil {
+				continue
+			}
 		}
 
 		ms, err := subscription.Fetch(numMsgsToFetch, natsMaxWait)
+		if errors.Is(err, nats.ErrTimeout) {
+			continue
+		}
 		if err != nil {
 			fmt.Printf("Error fetching from topic: %s\n\terr: %v\n",
 				topic, err)
@@ -114,23 +271,36 @@ LOOP:
 			continue
 		}
 
-		for _, m := range ms {
-			m.Ack()
-
-			var docDownload pb.DocDownload
+		// The client acknowledges each message once it processed it.
+		for i, m := range ms {
+			ackToken := subs.acks.add(string(servId), m)
 
-			err := proto.Unmarshal(m.Data, &docDownload)
-			if err != nil {
-				fmt.Printf("Error unmarshalling download document: %v\n", err)
-				break LOOP
+			var err error
+			if m.Data, m.Header, err = subs.encryption.open(m); err == nil {
+				err = send(m, ackToken)
 			}
+			if errors.Is(err, errUnknownKey) || errors.Is(err, errDecrypt) {
 
-			if err = stream.Send(&pb.DocDownload{
-				Documents: docDownload.Documents,
-				MsgNumber: docDownload.MsgNumber,
-			}); err != nil {
+				// Some other client may have the key, or we may get it later.
+				fmt.Printf("Error decrypting download message: %v\n", err)
+				subs.acks.settled(ackToken, settleUndecryptable(m))
+				continue
+			}
+			if errors.Is(err, errBadMsg) {
+				fmt.Printf("Error decoding download message: %v\n", err)
+				subs.acks.settled(ackToken, ackTerm)
+				m.Term()
+				continue
+			}
+			if err != nil {
 
 				fmt.Printf("Error sending to document download stream: %v\n", err)
+
+				// Let some other client have the messages we did not send.
+				subs.acks.settled(ackToken, ackNak)
+				for _, unsent := range ms[i:] {
+					unsent.Nak()
+				}
 				break LOOP
 			}
 			fmt.Printf("<")
@@ -140,29 +310,34 @@ LOOP:
 	return nil
 }
 
-func (subs *Subs) AddJS(ctx context.Context, in *pb.AddJSMsg) (*pb.AddJSMsgResponse, error) {
+func (subs *Subs) AddJS(ctx context.Context, servId []byte,
+	in *pb.AddJSMsg) (*pb.AddJSMsgResponse, error) {
 
 	topic := in.GetTopic()
 	workQueue := in.GetWorkQueue()
-	chanSize := viper.GetInt("nats.jetstream.goroutineChanSize")
 
-	topicMsgs := make(chan *nats.Msg, chanSize)
-	subs.natsJSMsgs[topic] = topicMsgs
+	stream := in.GetStream()
+	if stream == "" {
+		stream = viper.GetString("nats.jetstream.name")
+	}
 
-	subscription, err := subs.natsConn.js.PullSubscribe(topic, workQueue,
-		// nats.PullMaxWaiting(512),
-		nats.ManualAck(),
-		nats.DeliverAll(),
-		// nats.MaxDeliver(32),
-		nats.BindStream("uploadDocs"),
-	)
+	subscription, err := subs.natsConn.pullSubscribeJS(docStreamBinding{
+		subject:     topic,
+		stream:      stream,
+		durableName: workQueue,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("Could not subscribe: topic: %s workQueue: %s: %w",
-			topic, workQueue, err)
+		return nil, err
 	}
 
-	subs.subscriptionsJS[topic] = subscription
-	subs.currentStreamTopic = topic
+	key := jsSubKey{servId: string(servId), topic: topic}
+
+	subs.mu.Lock()
+	if old, ok := subs.subscriptionsJS[key]; ok {
+		old.Unsubscribe()
+	}
+	subs.subscriptionsJS[key] = subscription
+	subs.mu.Unlock()
 
 	subJSMsgRsp := &pb.AddJSMsgResponse{
 		Header: &pb.Header{
@@ -183,17 +358,22 @@ func (subs *Subs) AddJS(ctx context.Context, in *pb.AddJSMsg) (*pb.AddJSMsgRespo
 	return subJSMsgRsp, nil
 }
 
-func (subs *Subs) UnsubscribeJS(logger *log.Logger, in *pb.UnsubJSMsg) (*pb.UnsubJSMsgResponse, error) {
+func (subs *Subs) UnsubscribeJS(logger *log.Logger, servId []byte,
+	in *pb.UnsubJSMsg) (*pb.UnsubJSMsgResponse, error) {
 
 	topic := in.GetTopic()
 	workQueue := in.GetWorkQueue()
+	key := jsSubKey{servId: string(servId), topic: topic}
+
+	subs.mu.Lock()
+	defer subs.mu.Unlock()
 
-	if _, ok := subs.subscriptionsJS[topic]; !ok {
+	if _, ok := subs.subscriptionsJS[key]; !ok {
 		return nil, fmt.Errorf("Error - topic not found to unsubscribe:\n\ttopic: %s\n\tworkQueue: %s\n",
 			topic, workQueue)
 	}
 
-	unsubscribeJS(subs, topic)
+	subs.unsubscribeJSLocked(key)
 
 	unsubJSMsgRsp := &pb.UnsubJSMsgResponse{
 		Header: &pb.Header{
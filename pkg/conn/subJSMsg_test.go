@@ -0,0 +1,218 @@
+package conn
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// quietControl is a download stream on which the client sends
+// nothing after the message that opened it.
+type quietControl struct {
+	ctx context.Context
+}
+
+func (c *quietControl) Recv() (*pb.DocDownloadResponse, error) {
+
+	<-c.ctx.Done()
+	return nil, io.EOF
+}
+
+// addTestDownloadStream adds a stream for the rest of the test,
+// and returns its name and subject.
+func addTestDownloadStream(t *testing.T, natsConn *Conn) (string, string) {
+
+	name := fmt.Sprintf("testDownload%d", time.Now().UnixNano())
+	topic := "test.download." + name
+	if _, err := natsConn.ManageStream(streamAdd, name, &pb.StreamConfig{
+		Name:     name,
+		Subjects: []string{topic},
+		Storage:  pb.StorageType_STORAGE_MEMORY,
+	}); err != nil {
+		t.Fatalf("Error adding stream\n\terr: %v\n", err)
+	}
+	t.Cleanup(func() { natsConn.ManageStream(streamDelete, name, nil) })
+
+	return name, topic
+}
+
+func TestDownloadFirstFlow(t *testing.T) {
+
+	defer setConfig(map[string]interface{}{
+		"nats.jetstream.fetch.numMsgs":          10,
+		"nats.jetstream.fetch.timeoutInSecs":    "100ms",
+		"nats.jetstream.flowControlTimeoutInNs": "10ms",
+	})()
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitSubs(natsConn, srv)
+	subs := srv.Subs
+
+	name, topic := addTestDownloadStream(t, natsConn)
+
+	js, _ := natsConn.JetStream()
+	if _, err = js.Publish(topic, []byte("doc")); err != nil {
+		t.Fatalf("Error publishing\n\terr: %v\n", err)
+	}
+
+	b := docStreamBinding{subject: topic, stream: name, durableName: name}
+
+	// download returns whether a message was sent before the timeout.
+	download := func(flow pb.StreamFlow, timeout time.Duration) bool {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		sent := false
+		err := subs.downloadJS(ctx, []byte("client"), b, flow, &quietControl{ctx},
+			func(m *nats.Msg, ackToken string) error {
+				sent = true
+				m.Nak()
+				cancel()
+				return nil
+			})
+		if err != nil {
+			t.Errorf("Error downloading\n\terr: %v\n", err)
+		}
+
+		return sent
+	}
+
+	if download(pb.StreamFlow_OFF, 300*time.Millisecond) {
+		t.Errorf("Expected nothing sent while the stream was opened with flow OFF\n")
+	}
+
+	if !download(pb.StreamFlow_ON, 5*time.Second) {
+		t.Errorf("Expected a message sent on a stream opened with flow ON\n")
+	}
+}
+
+func TestDownloadUnknownKey(t *testing.T) {
+
+	defer setConfig(map[string]interface{}{
+		"nats.jetstream.fetch.numMsgs":          10,
+		"nats.jetstream.fetch.timeoutInSecs":    "100ms",
+		"nats.jetstream.flowControlTimeoutInNs": "10ms",
+		"encryption.retryDelay":                 "200ms",
+		"encryption.maxDeliveries":              2,
+	})()
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	name, topic := addTestDownloadStream(t, natsConn)
+
+	keyFile := filepath.Join(t.TempDir(), "docs")
+	appendTestKey(t, keyFile, "k1")
+
+	srv := &Server{}
+	if srv.Encryption, err = newEncryption([]topicKeyFile{{Topic: topic, KeyFile: keyFile}}, -1); err != nil {
+		t.Fatalf("Error loading keys\n\terr: %v\n", err)
+	}
+	InitSubs(natsConn, srv)
+
+	js, _ := natsConn.JetStream()
+	m := &nats.Msg{Subject: topic, Data: []byte("sealed"), Header: nats.Header{keyIdHeader: {"k2"}}}
+	if _, err = js.PublishMsg(m); err != nil {
+		t.Fatalf("Error publishing\n\terr: %v\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	b := docStreamBinding{subject: topic, stream: name, durableName: name}
+	err = srv.Subs.downloadJS(ctx, []byte("client"), b, pb.StreamFlow_ON, &quietControl{ctx},
+		func(m *nats.Msg, ackToken string) error {
+			t.Errorf("Expected a message with an unknown key not to be sent\n")
+			return nil
+		})
+	if err != nil {
+		t.Errorf("Error downloading\n\terr: %v\n", err)
+	}
+
+	// Delivered once, again after the delay, and then terminated.
+	info, err := js.ConsumerInfo(name, name)
+	if err != nil {
+		t.Fatalf("Error getting consumer info\n\terr: %v\n", err)
+	}
+	if info.Delivered.Consumer != 2 || info.NumAckPending != 0 {
+		t.Errorf("Expected 2 deliveries and nothing pending. Got: %d deliveries, %d pending\n",
+			info.Delivered.Consumer, info.NumAckPending)
+	}
+}
+
+// testDocDownloadStream keeps what is sent on it, and
+// ends the download after the first document.
+type testDocDownloadStream struct {
+	grpc.ServerStream
+	quietControl
+	cancel context.CancelFunc
+	sent   []*pb.DocDownload
+}
+
+func (s *testDocDownloadStream) Send(d *pb.DocDownload) error {
+
+	s.sent = append(s.sent, d)
+	s.cancel()
+	return nil
+}
+
+func TestDownloadHeaders(t *testing.T) {
+
+	defer setConfig(map[string]interface{}{
+		"nats.jetstream.fetch.numMsgs":          10,
+		"nats.jetstream.fetch.timeoutInSecs":    "100ms",
+		"nats.jetstream.flowControlTimeoutInNs": "10ms",
+	})()
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitSubs(natsConn, srv)
+
+	name, topic := addTestDownloadStream(t, natsConn)
+
+	data, _ := proto.Marshal(&pb.DocDownload{MsgNumber: 7})
+	js, _ := natsConn.JetStream()
+	if _, err = js.PublishMsg(&nats.Msg{Subject: topic, Data: data,
+		Header: nats.Header{"Tenant": {"acme"}}}); err != nil {
+		t.Fatalf("Error publishing\n\terr: %v\n", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream := &testDocDownloadStream{quietControl: quietControl{ctx}, cancel: cancel}
+	b := docStreamBinding{subject: topic, stream: name, durableName: name}
+	if err = srv.Subs.DownloadJS(ctx, []byte("client"), b, pb.StreamFlow_ON, stream); err != nil {
+		t.Fatalf("Error downloading\n\terr: %v\n", err)
+	}
+
+	if len(stream.sent) != 1 {
+		t.Fatalf("Expected 1 document sent. Got: %d\n", len(stream.sent))
+	}
+	tenant := stream.sent[0].Headers["Tenant"].GetValues()
+	if stream.sent[0].MsgNumber != 7 || len(tenant) != 1 || tenant[0] != "acme" {
+		t.Errorf("Expected document 7 with its Tenant header. Got: %s\n", stream.sent[0])
+	}
+}
@@ -3,6 +3,8 @@ package conn
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 
 	"github.com/find-in-docs/sidecar/pkg/log"
 	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
@@ -13,50 +15,237 @@ const (
 	msgChSize = 10
 )
 
+// subscription is one client's subscription to a topic. A client may
+// subscribe to the same topic more than once. Each subscription gets its
+// own ID and its own channel of messages.
+type subscription struct {
+	id         uint64
+	servId     string
+	topic      string
+	queueGroup string
+
+	msgs chan *nats.Msg
+
+	// done is closed on unsubscribe. msgs is never closed,
+	// since the NATS callback may still be sending on it.
+	done chan struct{}
+
+	overflow pb.OverflowPolicy
+	spill    *spillBuffer
+	dropped  atomic.Uint64
+
+	shared *natsSub
+}
+
+type natsSubKey struct {
+	topic      string
+	queueGroup string
+}
+
+// natsSub is a NATS subscription shared by every subscription to the same
+// topic and queue group on this sidecar. It is released when the last
+// of them unsubscribes.
+type natsSub struct {
+	key          natsSubKey
+	subscription *nats.Subscription
+	members      []*subscription
+
+	// Members of a queue group take turns, so that each message is
+	// handled once, just like across sidecars.
+	next int
+}
+
+type subKey struct {
+	servId string
+	id     uint64
+}
+
+type jsSubKey struct {
+	servId string
+	topic  string
+}
+
 type Subs struct {
-	natsMsgs           map[string]chan *nats.Msg
-	natsJSMsgs         map[string]chan *nats.Msg
-	subscriptions      map[string]*nats.Subscription
-	subscriptionsJS    map[string]*nats.Subscription
-	currentStreamTopic string
-	msgId              uint32
-	natsConn           *Conn
-	header             *pb.Header
+	// GRPC handles each request in its own goroutine, and NATS calls
+	// back on its own goroutines, so everything below is guarded by mu.
+	mu              sync.Mutex
+	subs            map[subKey]*subscription
+	natsSubs        map[natsSubKey]*natsSub
+	nextSubId       uint64
+	subscriptionsJS map[jsSubKey]*nats.Subscription
+
+	acks    *pendingAcks
+	replies *pendingReplies
+	owners  *jsOwners
+
+	msgId    uint32
+	natsConn *Conn
+	header   *pb.Header
+
+	encryption *Encryption
 }
 
 func InitSubs(natsConn *Conn, srv *Server) {
 
-	natsMsgs := make(map[string]chan *nats.Msg)
-	natsJSMsgs := make(map[string]chan *nats.Msg)
-	subscriptions := make(map[string]*nats.Subscription)
-	subscriptionsJS := make(map[string]*nats.Subscription)
-
 	srv.Subs = &Subs{
 
-		natsMsgs:        natsMsgs,
-		natsJSMsgs:      natsJSMsgs,
-		subscriptions:   subscriptions,
-		subscriptionsJS: subscriptionsJS,
+		subs:            make(map[subKey]*subscription),
+		natsSubs:        make(map[natsSubKey]*natsSub),
+		subscriptionsJS: make(map[jsSubKey]*nats.Subscription),
+		acks:            newPendingAcks(),
+		replies:         newPendingReplies(),
+		owners:          newJSOwners(),
 		msgId:           1,
 		natsConn:        natsConn,
+		encryption:      srv.Encryption,
 	}
 }
 
-func (subs *Subs) Subscribe(in *pb.SubMsg) (*pb.SubMsgResponse, error) {
+// deliver sends a message from NATS to the subscriptions sharing it.
+func (subs *Subs) deliver(shared *natsSub, m *nats.Msg) {
 
-	topic := in.GetTopic()
+	subs.mu.Lock()
+
+	var members []*subscription
+	if n := len(shared.members); n > 0 {
+		if shared.key.queueGroup != "" {
+			shared.next = (shared.next + 1) % n
+			members = []*subscription{shared.members[shared.next]}
+		} else {
+			members = append(members, shared.members...)
+		}
+	}
+
+	subs.mu.Unlock()
+
+	for _, sub := range members {
+		sub.send(m)
+	}
+}
+
+func (subs *Subs) subscribe(servId []byte, in *pb.SubMsg) (*subscription, error) {
+
+	subs.mu.Lock()
+	defer subs.mu.Unlock()
+
+	key := natsSubKey{topic: in.GetTopic(), queueGroup: in.GetQueueGroup()}
+
+	shared, ok := subs.natsSubs[key]
+	if !ok {
+		shared = &natsSub{key: key}
+
+		handler := func(m *nats.Msg) {
+			subs.deliver(shared, m)
+		}
+
+		var err error
+		if key.queueGroup != "" {
+			shared.subscription, err = subs.natsConn.QueueSubscribe(key.topic, key.queueGroup, handler)
+		} else {
+			shared.subscription, err = subs.natsConn.Subscribe(key.topic, handler)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		subs.natsSubs[key] = shared
+	}
+
+	// Dropping needs somewhere to drop from.
 	chanSize := in.GetChanSize()
+	if chanSize == 0 && in.GetOverflow() != pb.OverflowPolicy_OVERFLOW_BLOCK {
+		chanSize = 1
+	}
+
+	subs.nextSubId++
+	sub := &subscription{
+		id:         subs.nextSubId,
+		servId:     string(servId),
+		topic:      key.topic,
+		queueGroup: key.queueGroup,
+		msgs:       make(chan *nats.Msg, chanSize),
+		done:       make(chan struct{}),
+		overflow:   in.GetOverflow(),
+		shared:     shared,
+	}
+
+	if sub.overflow == pb.OverflowPolicy_OVERFLOW_SPILL {
+		sub.spill = newSpillBuffer(in.GetSpillMaxBytes())
+		go sub.drainSpill()
+	}
+
+	shared.members = append(shared.members, sub)
+	subs.subs[subKey{servId: sub.servId, id: sub.id}] = sub
+
+	return sub, nil
+}
+
+// removeLocked ends a subscription, and releases its NATS subscription
+// if nobody else uses it. subs.mu must be held.
+func (subs *Subs) removeLocked(sub *subscription) {
+
+	key := subKey{servId: sub.servId, id: sub.id}
+	if subs.subs[key] != sub {
+		return
+	}
 
-	topicMsgs := make(chan *nats.Msg, chanSize)
-	subs.natsMsgs[topic] = topicMsgs
+	delete(subs.subs, key)
+	close(sub.done)
+
+	shared := sub.shared
+	for i, member := range shared.members {
+		if member == sub {
+			shared.members = append(shared.members[:i], shared.members[i+1:]...)
+			break
+		}
+	}
+
+	if len(shared.members) == 0 {
+		if err := shared.subscription.Unsubscribe(); err != nil {
+			fmt.Printf("Error unsubscribing from NATS:\n\ttopic: %s\n\terr: %v\n",
+				shared.key.topic, err)
+		}
+		delete(subs.natsSubs, shared.key)
+	}
+}
+
+func (subs *Subs) remove(sub *subscription) {
+
+	subs.mu.Lock()
+	defer subs.mu.Unlock()
+
+	subs.removeLocked(sub)
+}
+
+// find returns the client's subscription with subId. A zero subId
+// returns the client's oldest subscription to topic instead.
+func (subs *Subs) find(servId []byte, topic string, subId uint64) (*subscription, bool) {
+
+	subs.mu.Lock()
+	defer subs.mu.Unlock()
+
+	if subId != 0 {
+		sub, ok := subs.subs[subKey{servId: string(servId), id: subId}]
+		return sub, ok
+	}
 
-	subscription, err := subs.natsConn.Subscribe(topic, func(m *nats.Msg) {
-		subs.natsMsgs[topic] <- m
-	})
+	var found *subscription
+	for key, sub := range subs.subs {
+		if key.servId == string(servId) && sub.topic == topic &&
+			(found == nil || sub.id < found.id) {
+			found = sub
+		}
+	}
+
+	return found, found != nil
+}
+
+func (subs *Subs) Subscribe(servId []byte, in *pb.SubMsg) (*pb.SubMsgResponse, error) {
+
+	sub, err := subs.subscribe(servId, in)
 	if err != nil {
 		return nil, err
 	}
-	subs.subscriptions[topic] = subscription
 
 	subMsgRsp := &pb.SubMsgResponse{
 		Header: &pb.Header{
@@ -71,7 +260,8 @@ func (subs *Subs) Subscribe(in *pb.SubMsg) (*pb.SubMsgResponse, error) {
 			Status: uint32(pb.Status_OK),
 		},
 
-		Msg: "OK",
+		Msg:   "OK",
+		SubId: sub.id,
 	}
 
 	return subMsgRsp, nil
@@ -79,49 +269,165 @@ func (subs *Subs) Subscribe(in *pb.SubMsg) (*pb.SubMsgResponse, error) {
 
 func RecvFromNATS(ctx context.Context, srv *Server, in *pb.Receive) (*pb.SubTopicResponse, error) {
 
-	natsMsgs, ok := srv.Subs.natsMsgs[in.Topic]
+	sub, ok := srv.Subs.find(in.Header.ServId, in.Topic, in.SubId)
 	if !ok {
 		return nil, fmt.Errorf("Warning - already unsubscribed from topic: %s\n", in.Topic)
 	}
 
 	select {
 
-	case m := <-natsMsgs:
-		if m == nil {
-			return nil, fmt.Errorf("Warning - already unsubscribed from topic: %s\n", in.Topic)
-		}
-
+	case m := <-sub.msgs:
 		s := fmt.Sprintf("Header:%s\n\tTopic: %s\n",
 			in.Header, in.Topic)
 		srv.Logs.logger.PrintMsg("Got msg from NATS server: %s\n", s)
 
-		subTopicRsp := &pb.SubTopicResponse{
-			Header: &pb.Header{
-				MsgType:     pb.MsgType_MSG_TYPE_SUB_TOPIC_RSP,
-				SrcServType: serviceType(),
-				DstServType: in.Header.SrcServType,
-				ServId:      serviceId()(),
-				MsgId:       NextMsgId(),
-			},
+		return srv.Subs.subTopicResponse(in.Header, sub, m)
+
+	case <-sub.done:
+		return nil, fmt.Errorf("Warning - already unsubscribed from topic: %s\n", in.Topic)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (subs *Subs) subTopicResponse(header *pb.Header, sub *subscription,
+	m *nats.Msg) (*pb.SubTopicResponse, error) {
+
+	data, natsHeader, err := subs.encryption.open(m)
+	if err != nil {
+		return nil, err
+	}
+
+	// The client answers a request with a token for its reply subject.
+	var reply string
+	if m.Reply != "" {
+		reply = subs.replies.add(sub.servId, m.Reply, m.Subject)
+	}
+
+	return &pb.SubTopicResponse{
+		Header: &pb.Header{
+			MsgType:     pb.MsgType_MSG_TYPE_SUB_TOPIC_RSP,
+			SrcServType: serviceType(),
+			DstServType: header.SrcServType,
+			ServId:      serviceId()(),
+			MsgId:       NextMsgId(),
+		},
+
+		Topic: m.Subject,
+
+		Msg: data,
 
-			Topic: m.Subject,
+		Reply: reply,
 
-			Msg: m.Data,
+		SubId: sub.id,
+
+		Dropped: sub.dropped.Load(),
+
+		Headers: pbHeaders(natsHeader),
+	}, nil
+}
+
+// StreamFromNATS sends the messages of a subscription to the stream until
+// ctx is done or the subscription ends. Unless the client asks for one of
+// its existing subscriptions, the stream gets a subscription of its own,
+// which ends with the stream.
+func StreamFromNATS(ctx context.Context, srv *Server, servId []byte, in *pb.SubMsg,
+	stream pb.Sidecar_SubscribeServer) error {
+
+	topic := in.GetTopic()
+
+	var sub *subscription
+	if in.GetSubId() != 0 {
+		var ok bool
+		sub, ok = srv.Subs.find(servId, topic, in.GetSubId())
+		if !ok {
+			return fmt.Errorf("Error - subscription not found:\n\ttopic: %s\n\tsubId: %d\n",
+				topic, in.GetSubId())
+		}
+	} else {
+		var err error
+		sub, err = srv.Subs.subscribe(servId, in)
+		if err != nil {
+			return err
 		}
 
-		return subTopicRsp, nil
+		defer srv.Subs.remove(sub)
+	}
 
-	case <-ctx.Done():
-		return nil, ctx.Err()
+	for {
+		select {
+
+		case m := <-sub.msgs:
+			rsp, err := srv.Subs.subTopicResponse(in.Header, sub, m)
+			if err != nil {
+				srv.Logs.logger.PrintMsg("Error - skipping message on topic: %s\n\terr: %v\n", topic, err)
+				continue
+			}
+
+			if err := stream.Send(rsp); err != nil {
+				return fmt.Errorf("Error sending to subscribe stream for topic: %s\n\terr: %w", topic, err)
+			}
+
+		case <-sub.done:
+			srv.Logs.logger.PrintMsg("Unsubscribed from topic: %s - ending stream\n", topic)
+			return nil
+
+		case <-ctx.Done():
+			return nil
+		}
 	}
 }
 
-func (subs *Subs) Unsubscribe(logger *log.Logger, in *pb.UnsubMsg) (*pb.UnsubMsgResponse, error) {
+// releaseClient ends every subscription the client still holds. JetStream
+// messages it did not settle are delivered again, to some other client.
+func (subs *Subs) releaseClient(servId []byte) {
+
+	for _, m := range subs.acks.releaseClient(servId) {
+		m.Nak()
+	}
+	subs.replies.releaseClient(servId)
+
+	subs.mu.Lock()
+	defer subs.mu.Unlock()
+
+	for key, sub := range subs.subs {
+		if key.servId == string(servId) {
+			subs.removeLocked(sub)
+		}
+	}
+
+	for key := range subs.subscriptionsJS {
+		if key.servId == string(servId) {
+			subs.unsubscribeJSLocked(key)
+		}
+	}
+}
+
+// Unsubscribe ends the subscription with the given subId. A zero subId
+// ends all of the client's subscriptions to the topic.
+func (subs *Subs) Unsubscribe(logger *log.Logger, servId []byte,
+	in *pb.UnsubMsg) (*pb.UnsubMsgResponse, error) {
 
 	topic := in.GetTopic()
 
-	close(subs.natsMsgs[topic])
-	delete(subs.natsMsgs, topic)
+	subs.mu.Lock()
+	if subId := in.GetSubId(); subId != 0 {
+		sub, ok := subs.subs[subKey{servId: string(servId), id: subId}]
+		if !ok {
+			subs.mu.Unlock()
+			return nil, fmt.Errorf("Error - subscription not found to unsubscribe:\n\ttopic: %s\n\tsubId: %d\n",
+				topic, subId)
+		}
+		subs.removeLocked(sub)
+	} else {
+		for key, sub := range subs.subs {
+			if key.servId == string(servId) && sub.topic == topic {
+				subs.removeLocked(sub)
+			}
+		}
+	}
+	subs.mu.Unlock()
 
 	unsubMsgRsp := &pb.UnsubMsgResponse{
 		Header: &pb.Header{
@@ -0,0 +1,156 @@
+package conn
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/find-in-docs/sidecar/protos/v1/messages"
+	"github.com/nats-io/nats.go"
+)
+
+func TestSubsSharedSubscription(t *testing.T) {
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitSubs(natsConn, srv)
+	subs := srv.Subs
+
+	topic := fmt.Sprintf("test.subs.%d", time.Now().UnixNano())
+
+	// Subscribe from many goroutines at once. They all share one
+	// NATS subscription, but each gets every message.
+	const numClients = 8
+	subscriptions := make([]*subscription, numClients)
+
+	var wg sync.WaitGroup
+	for i := 0; i < numClients; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			sub, err := subs.subscribe([]byte(fmt.Sprint("client", i)),
+				&pb.SubMsg{Topic: topic, ChanSize: 1})
+			if err != nil {
+				t.Errorf("Error subscribing\n\terr: %v\n", err)
+				return
+			}
+			subscriptions[i] = sub
+		}(i)
+	}
+	wg.Wait()
+
+	if len(subs.natsSubs) != 1 {
+		t.Fatalf("Expected 1 shared NATS subscription. Got: %d\n", len(subs.natsSubs))
+	}
+	shared := subscriptions[0].shared
+
+	natsConn.Publish(topic, []byte("hello"))
+	for i, sub := range subscriptions {
+		select {
+		case m := <-sub.msgs:
+			if string(m.Data) != "hello" {
+				t.Errorf("Client %d got wrong message: %s\n", i, m.Data)
+			}
+		case <-time.After(2 * time.Second):
+			t.Errorf("Client %d did not get the message\n", i)
+		}
+	}
+
+	if found, ok := subs.find([]byte("client3"), topic, 0); !ok || found != subscriptions[3] {
+		t.Errorf("Error finding subscription by topic\n")
+	}
+
+	// The NATS subscription stays until the last client lets go of it.
+	for i, sub := range subscriptions[:numClients-1] {
+		subs.remove(sub)
+
+		select {
+		case <-sub.done:
+		default:
+			t.Errorf("Client %d subscription not ended\n", i)
+		}
+	}
+
+	if !shared.subscription.IsValid() {
+		t.Errorf("Error - NATS subscription released while still in use\n")
+	}
+
+	subs.releaseClient([]byte(fmt.Sprint("client", numClients-1)))
+
+	if shared.subscription.IsValid() || len(subs.natsSubs) != 0 || len(subs.subs) != 0 {
+		t.Errorf("Error - NATS subscription not released after last unsubscribe\n")
+	}
+}
+
+func TestSubsQueueGroup(t *testing.T) {
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitSubs(natsConn, srv)
+	subs := srv.Subs
+
+	topic := fmt.Sprintf("test.queue.%d", time.Now().UnixNano())
+	in := &pb.SubMsg{Topic: topic, ChanSize: 10, QueueGroup: "workers"}
+
+	a, err := subs.subscribe([]byte("a"), in)
+	if err != nil {
+		t.Fatalf("Error subscribing\n\terr: %v\n", err)
+	}
+	b, err := subs.subscribe([]byte("b"), in)
+	if err != nil {
+		t.Fatalf("Error subscribing\n\terr: %v\n", err)
+	}
+
+	for i := 0; i < 4; i++ {
+		natsConn.Publish(topic, []byte("work"))
+	}
+	natsConn.nc.Flush()
+	time.Sleep(100 * time.Millisecond)
+
+	// Queue group members on the same sidecar take turns.
+	if len(a.msgs) != 2 || len(b.msgs) != 2 {
+		t.Errorf("Expected 2 messages each. Got: %d and %d\n", len(a.msgs), len(b.msgs))
+	}
+
+	subs.releaseClient([]byte("a"))
+	subs.releaseClient([]byte("b"))
+}
+
+func TestSubsInvalidTopic(t *testing.T) {
+
+	natsConn, err := NewNATSConn(nats.DefaultURL)
+	if err != nil {
+		t.Fatalf("Error connecting to NATS\n\terr: %v\n", err)
+	}
+	defer natsConn.nc.Close()
+
+	srv := &Server{}
+	InitSubs(natsConn, srv)
+	subs := srv.Subs
+
+	// The topic comes from the client. A bad one fails only the client.
+	for _, in := range []*pb.SubMsg{
+		{Topic: "test.bad topic", ChanSize: 1},
+		{Topic: "test.bad topic", ChanSize: 1, QueueGroup: "workers"},
+	} {
+		if _, err = subs.subscribe([]byte("client"), in); err == nil {
+			t.Errorf("Expected an error subscribing to: %q\n", in.Topic)
+		}
+	}
+
+	if len(subs.natsSubs) != 0 {
+		t.Errorf("Expected no NATS subscriptions. Got: %d\n", len(subs.natsSubs))
+	}
+}
@@ -0,0 +1,30 @@
+package conn
+
+import (
+	"strings"
+)
+
+// subjectMatches reports whether a NATS subject matches a pattern.
+// A '*' token matches any one token, and a trailing '>' matches
+// one or more tokens, just like NATS subscriptions.
+func subjectMatches(pattern, subject string) bool {
+
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+
+	for i, p := range patternTokens {
+		if p == ">" {
+			return i == len(patternTokens)-1 && len(subjectTokens) > i
+		}
+
+		if i >= len(subjectTokens) {
+			return false
+		}
+
+		if p != "*" && p != subjectTokens[i] {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(subjectTokens)
+}
@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	// "fmt"
+	"fmt"
   // "os"
 	// "time"
 
@@ -34,8 +34,15 @@ func main() {
 	}
 
 	conn.InitLogs(ctx, natsConn, srv)
+	if err = conn.InitEncryption(srv); err != nil {
+		fmt.Printf("Error initializing encryption:\n\terr: %v\n", err)
+		return
+	}
 	conn.InitPubs(natsConn, srv)
+	conn.InitRateLimits(srv)
 	conn.InitSubs(natsConn, srv)
+	conn.InitLeases(ctx, srv)
+	conn.InitDiscovery(ctx, natsConn, srv)
 
   /*
   This section was for testing if the GoRoutines are all
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -44,6 +45,24 @@ const (
 	MsgType_MSG_TYPE_UNSUB_JS         MsgType = 16
 	MsgType_MSG_TYPE_UNSUB_JS_RSP     MsgType = 17
 	MsgType_MSG_TYPE_ADD_JS           MsgType = 18
+	MsgType_MSG_TYPE_DEREG            MsgType = 19
+	MsgType_MSG_TYPE_DEREG_RSP        MsgType = 20
+	MsgType_MSG_TYPE_KEEPALIVE        MsgType = 21
+	MsgType_MSG_TYPE_KEEPALIVE_RSP    MsgType = 22
+	MsgType_MSG_TYPE_DISCOVER         MsgType = 23
+	MsgType_MSG_TYPE_DISCOVER_RSP     MsgType = 24
+	MsgType_MSG_TYPE_REQ              MsgType = 25
+	MsgType_MSG_TYPE_REQ_RSP          MsgType = 26
+	MsgType_MSG_TYPE_RESPOND          MsgType = 27
+	MsgType_MSG_TYPE_RESPOND_RSP      MsgType = 28
+	MsgType_MSG_TYPE_ACK              MsgType = 29
+	MsgType_MSG_TYPE_ACK_RSP          MsgType = 30
+	MsgType_MSG_TYPE_STREAM           MsgType = 31
+	MsgType_MSG_TYPE_STREAM_RSP       MsgType = 32
+	MsgType_MSG_TYPE_CONSUMER         MsgType = 33
+	MsgType_MSG_TYPE_CONSUMER_RSP     MsgType = 34
+	MsgType_MSG_TYPE_PUB_ACKS         MsgType = 35
+	MsgType_MSG_TYPE_PUB_ACK_RESULT   MsgType = 36
 )
 
 // Enum value maps for MsgType.
@@ -68,6 +87,24 @@ var (
 		16: "MSG_TYPE_UNSUB_JS",
 		17: "MSG_TYPE_UNSUB_JS_RSP",
 		18: "MSG_TYPE_ADD_JS",
+		19: "MSG_TYPE_DEREG",
+		20: "MSG_TYPE_DEREG_RSP",
+		21: "MSG_TYPE_KEEPALIVE",
+		22: "MSG_TYPE_KEEPALIVE_RSP",
+		23: "MSG_TYPE_DISCOVER",
+		24: "MSG_TYPE_DISCOVER_RSP",
+		25: "MSG_TYPE_REQ",
+		26: "MSG_TYPE_REQ_RSP",
+		27: "MSG_TYPE_RESPOND",
+		28: "MSG_TYPE_RESPOND_RSP",
+		29: "MSG_TYPE_ACK",
+		30: "MSG_TYPE_ACK_RSP",
+		31: "MSG_TYPE_STREAM",
+		32: "MSG_TYPE_STREAM_RSP",
+		33: "MSG_TYPE_CONSUMER",
+		34: "MSG_TYPE_CONSUMER_RSP",
+		35: "MSG_TYPE_PUB_ACKS",
+		36: "MSG_TYPE_PUB_ACK_RESULT",
 	}
 	MsgType_value = map[string]int32{
 		"MSG_TYPE_REG":              0,
@@ -89,6 +126,24 @@ var (
 		"MSG_TYPE_UNSUB_JS":         16,
 		"MSG_TYPE_UNSUB_JS_RSP":     17,
 		"MSG_TYPE_ADD_JS":           18,
+		"MSG_TYPE_DEREG":            19,
+		"MSG_TYPE_DEREG_RSP":        20,
+		"MSG_TYPE_KEEPALIVE":        21,
+		"MSG_TYPE_KEEPALIVE_RSP":    22,
+		"MSG_TYPE_DISCOVER":         23,
+		"MSG_TYPE_DISCOVER_RSP":     24,
+		"MSG_TYPE_REQ":              25,
+		"MSG_TYPE_REQ_RSP":          26,
+		"MSG_TYPE_RESPOND":          27,
+		"MSG_TYPE_RESPOND_RSP":      28,
+		"MSG_TYPE_ACK":              29,
+		"MSG_TYPE_ACK_RSP":          30,
+		"MSG_TYPE_STREAM":           31,
+		"MSG_TYPE_STREAM_RSP":       32,
+		"MSG_TYPE_CONSUMER":         33,
+		"MSG_TYPE_CONSUMER_RSP":     34,
+		"MSG_TYPE_PUB_ACKS":         35,
+		"MSG_TYPE_PUB_ACK_RESULT":   36,
 	}
 )
 
@@ -122,12 +177,14 @@ func (MsgType) EnumDescriptor() ([]byte, []int) {
 type Status int32
 
 const (
-	Status_OK                Status = 0
-	Status_ERR_SENDING_MSG   Status = 1
-	Status_ERR_RECEIVING_MSG Status = 2
-	Status_ERR_SUBSCRIBING   Status = 3
-	Status_ERR_PUBLISHING    Status = 4
-	Status_ERR_LOGGING       Status = 5
+	Status_OK                 Status = 0
+	Status_ERR_SENDING_MSG    Status = 1
+	Status_ERR_RECEIVING_MSG  Status = 2
+	Status_ERR_SUBSCRIBING    Status = 3
+	Status_ERR_PUBLISHING     Status = 4
+	Status_ERR_LOGGING        Status = 5
+	Status_ERR_NOT_REGISTERED Status = 6
+	Status_ERR_CIRCUIT_OPEN   Status = 7
 )
 
 // Enum value maps for Status.
@@ -139,14 +196,18 @@ var (
 		3: "ERR_SUBSCRIBING",
 		4: "ERR_PUBLISHING",
 		5: "ERR_LOGGING",
+		6: "ERR_NOT_REGISTERED",
+		7: "ERR_CIRCUIT_OPEN",
 	}
 	Status_value = map[string]int32{
-		"OK":                0,
-		"ERR_SENDING_MSG":   1,
-		"ERR_RECEIVING_MSG": 2,
-		"ERR_SUBSCRIBING":   3,
-		"ERR_PUBLISHING":    4,
-		"ERR_LOGGING":       5,
+		"OK":                 0,
+		"ERR_SENDING_MSG":    1,
+		"ERR_RECEIVING_MSG":  2,
+		"ERR_SUBSCRIBING":    3,
+		"ERR_PUBLISHING":     4,
+		"ERR_LOGGING":        5,
+		"ERR_NOT_REGISTERED": 6,
+		"ERR_CIRCUIT_OPEN":   7,
 	}
 )
 
@@ -177,6 +238,217 @@ func (Status) EnumDescriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{1}
 }
 
+// Classes of errors a request can be retried on.
+type RetryableError int32
+
+const (
+	// Nobody is subscribed to the topic.
+	RetryableError_RETRY_NO_RESPONDERS RetryableError = 0
+	// A responder did not answer in time.
+	RetryableError_RETRY_TIMEOUT RetryableError = 1
+	// The sidecar is reconnecting to NATS.
+	RetryableError_RETRY_CONNECTION RetryableError = 2
+)
+
+// Enum value maps for RetryableError.
+var (
+	RetryableError_name = map[int32]string{
+		0: "RETRY_NO_RESPONDERS",
+		1: "RETRY_TIMEOUT",
+		2: "RETRY_CONNECTION",
+	}
+	RetryableError_value = map[string]int32{
+		"RETRY_NO_RESPONDERS": 0,
+		"RETRY_TIMEOUT":       1,
+		"RETRY_CONNECTION":    2,
+	}
+)
+
+func (x RetryableError) Enum() *RetryableError {
+	p := new(RetryableError)
+	*p = x
+	return p
+}
+
+func (x RetryableError) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetryableError) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_v1_messages_sidecar_proto_enumTypes[2].Descriptor()
+}
+
+func (RetryableError) Type() protoreflect.EnumType {
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[2]
+}
+
+func (x RetryableError) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetryableError.Descriptor instead.
+func (RetryableError) EnumDescriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{2}
+}
+
+type DebounceMode int32
+
+const (
+	// Publish the first message of a burst of identical messages.
+	DebounceMode_DEBOUNCE_FIRST DebounceMode = 0
+	// Publish the last message, once no identical message came in for the debounce delay.
+	DebounceMode_DEBOUNCE_LAST DebounceMode = 1
+	DebounceMode_DEBOUNCE_OFF  DebounceMode = 2
+)
+
+// Enum value maps for DebounceMode.
+var (
+	DebounceMode_name = map[int32]string{
+		0: "DEBOUNCE_FIRST",
+		1: "DEBOUNCE_LAST",
+		2: "DEBOUNCE_OFF",
+	}
+	DebounceMode_value = map[string]int32{
+		"DEBOUNCE_FIRST": 0,
+		"DEBOUNCE_LAST":  1,
+		"DEBOUNCE_OFF":   2,
+	}
+)
+
+func (x DebounceMode) Enum() *DebounceMode {
+	p := new(DebounceMode)
+	*p = x
+	return p
+}
+
+func (x DebounceMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DebounceMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_v1_messages_sidecar_proto_enumTypes[3].Descriptor()
+}
+
+func (DebounceMode) Type() protoreflect.EnumType {
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[3]
+}
+
+func (x DebounceMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DebounceMode.Descriptor instead.
+func (DebounceMode) EnumDescriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{3}
+}
+
+// How PubJS waits for JetStream to store the message.
+type PubAckMode int32
+
+const (
+	// Wait for JetStream, and return its PubAck.
+	PubAckMode_PUB_ACK_SYNC PubAckMode = 0
+	// Return right away, with a pubId. The PubAck, or the error,
+	// comes later on PubAcks, with the same pubId.
+	PubAckMode_PUB_ACK_ASYNC PubAckMode = 1
+)
+
+// Enum value maps for PubAckMode.
+var (
+	PubAckMode_name = map[int32]string{
+		0: "PUB_ACK_SYNC",
+		1: "PUB_ACK_ASYNC",
+	}
+	PubAckMode_value = map[string]int32{
+		"PUB_ACK_SYNC":  0,
+		"PUB_ACK_ASYNC": 1,
+	}
+)
+
+func (x PubAckMode) Enum() *PubAckMode {
+	p := new(PubAckMode)
+	*p = x
+	return p
+}
+
+func (x PubAckMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PubAckMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_v1_messages_sidecar_proto_enumTypes[4].Descriptor()
+}
+
+func (PubAckMode) Type() protoreflect.EnumType {
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[4]
+}
+
+func (x PubAckMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PubAckMode.Descriptor instead.
+func (PubAckMode) EnumDescriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{4}
+}
+
+// What to do with messages for a subscriber whose buffer is full.
+type OverflowPolicy int32
+
+const (
+	// Wait for the subscriber. This holds up every other subscriber
+	// of the topic on this sidecar.
+	OverflowPolicy_OVERFLOW_BLOCK       OverflowPolicy = 0
+	OverflowPolicy_OVERFLOW_DROP_OLDEST OverflowPolicy = 1
+	OverflowPolicy_OVERFLOW_DROP_NEWEST OverflowPolicy = 2
+	// Keep the messages in a file until the subscriber catches up.
+	// Once the file is full, drop the newest messages.
+	OverflowPolicy_OVERFLOW_SPILL OverflowPolicy = 3
+)
+
+// Enum value maps for OverflowPolicy.
+var (
+	OverflowPolicy_name = map[int32]string{
+		0: "OVERFLOW_BLOCK",
+		1: "OVERFLOW_DROP_OLDEST",
+		2: "OVERFLOW_DROP_NEWEST",
+		3: "OVERFLOW_SPILL",
+	}
+	OverflowPolicy_value = map[string]int32{
+		"OVERFLOW_BLOCK":       0,
+		"OVERFLOW_DROP_OLDEST": 1,
+		"OVERFLOW_DROP_NEWEST": 2,
+		"OVERFLOW_SPILL":       3,
+	}
+)
+
+func (x OverflowPolicy) Enum() *OverflowPolicy {
+	p := new(OverflowPolicy)
+	*p = x
+	return p
+}
+
+func (x OverflowPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OverflowPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_v1_messages_sidecar_proto_enumTypes[5].Descriptor()
+}
+
+func (OverflowPolicy) Type() protoreflect.EnumType {
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[5]
+}
+
+func (x OverflowPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OverflowPolicy.Descriptor instead.
+func (OverflowPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{5}
+}
+
 type StreamFlow int32
 
 const (
@@ -216,11 +488,11 @@ func (x StreamFlow) String() string {
 }
 
 func (StreamFlow) Descriptor() protoreflect.EnumDescriptor {
-	return file_protos_v1_messages_sidecar_proto_enumTypes[2].Descriptor()
+	return file_protos_v1_messages_sidecar_proto_enumTypes[6].Descriptor()
 }
 
 func (StreamFlow) Type() protoreflect.EnumType {
-	return &file_protos_v1_messages_sidecar_proto_enumTypes[2]
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[6]
 }
 
 func (x StreamFlow) Number() protoreflect.EnumNumber {
@@ -229,7 +501,154 @@ func (x StreamFlow) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StreamFlow.Descriptor instead.
 func (StreamFlow) EnumDescriptor() ([]byte, []int) {
-	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{2}
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{6}
+}
+
+type RetentionPolicy int32
+
+const (
+	// Keep messages until a limit on the stream is reached.
+	RetentionPolicy_RETENTION_LIMITS RetentionPolicy = 0
+	// Keep messages until every consumer acknowledged them.
+	RetentionPolicy_RETENTION_INTEREST RetentionPolicy = 1
+	// Keep messages until one consumer acknowledged them.
+	RetentionPolicy_RETENTION_WORK_QUEUE RetentionPolicy = 2
+)
+
+// Enum value maps for RetentionPolicy.
+var (
+	RetentionPolicy_name = map[int32]string{
+		0: "RETENTION_LIMITS",
+		1: "RETENTION_INTEREST",
+		2: "RETENTION_WORK_QUEUE",
+	}
+	RetentionPolicy_value = map[string]int32{
+		"RETENTION_LIMITS":     0,
+		"RETENTION_INTEREST":   1,
+		"RETENTION_WORK_QUEUE": 2,
+	}
+)
+
+func (x RetentionPolicy) Enum() *RetentionPolicy {
+	p := new(RetentionPolicy)
+	*p = x
+	return p
+}
+
+func (x RetentionPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetentionPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_v1_messages_sidecar_proto_enumTypes[7].Descriptor()
+}
+
+func (RetentionPolicy) Type() protoreflect.EnumType {
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[7]
+}
+
+func (x RetentionPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetentionPolicy.Descriptor instead.
+func (RetentionPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{7}
+}
+
+type StorageType int32
+
+const (
+	StorageType_STORAGE_FILE   StorageType = 0
+	StorageType_STORAGE_MEMORY StorageType = 1
+)
+
+// Enum value maps for StorageType.
+var (
+	StorageType_name = map[int32]string{
+		0: "STORAGE_FILE",
+		1: "STORAGE_MEMORY",
+	}
+	StorageType_value = map[string]int32{
+		"STORAGE_FILE":   0,
+		"STORAGE_MEMORY": 1,
+	}
+)
+
+func (x StorageType) Enum() *StorageType {
+	p := new(StorageType)
+	*p = x
+	return p
+}
+
+func (x StorageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StorageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_v1_messages_sidecar_proto_enumTypes[8].Descriptor()
+}
+
+func (StorageType) Type() protoreflect.EnumType {
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[8]
+}
+
+func (x StorageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StorageType.Descriptor instead.
+func (StorageType) EnumDescriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{8}
+}
+
+type AckPolicy int32
+
+const (
+	AckPolicy_ACK_POLICY_EXPLICIT AckPolicy = 0
+	AckPolicy_ACK_POLICY_NONE     AckPolicy = 1
+	AckPolicy_ACK_POLICY_ALL      AckPolicy = 2
+)
+
+// Enum value maps for AckPolicy.
+var (
+	AckPolicy_name = map[int32]string{
+		0: "ACK_POLICY_EXPLICIT",
+		1: "ACK_POLICY_NONE",
+		2: "ACK_POLICY_ALL",
+	}
+	AckPolicy_value = map[string]int32{
+		"ACK_POLICY_EXPLICIT": 0,
+		"ACK_POLICY_NONE":     1,
+		"ACK_POLICY_ALL":      2,
+	}
+)
+
+func (x AckPolicy) Enum() *AckPolicy {
+	p := new(AckPolicy)
+	*p = x
+	return p
+}
+
+func (x AckPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AckPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_v1_messages_sidecar_proto_enumTypes[9].Descriptor()
+}
+
+func (AckPolicy) Type() protoreflect.EnumType {
+	return &file_protos_v1_messages_sidecar_proto_enumTypes[9]
+}
+
+func (x AckPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AckPolicy.Descriptor instead.
+func (AckPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{9}
 }
 
 type Header struct {
@@ -363,8 +782,19 @@ type RetryBehavior struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RetryNum   uint32               `protobuf:"varint,1,opt,name=retryNum,proto3" json:"retryNum,omitempty"`
+	RetryNum uint32 `protobuf:"varint,1,opt,name=retryNum,proto3" json:"retryNum,omitempty"`
+	// Delay before the first retry. Each retry after that waits
+	// multiplier times longer than the one before, up to maxDelay.
 	RetryDelay *durationpb.Duration `protobuf:"bytes,2,opt,name=retryDelay,proto3" json:"retryDelay,omitempty"`
+	MaxDelay   *durationpb.Duration `protobuf:"bytes,3,opt,name=maxDelay,proto3" json:"maxDelay,omitempty"`
+	Multiplier float64              `protobuf:"fixed64,4,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// Fraction of each delay to randomly add or take away,
+	// so that clients do not all retry at the same time.
+	Jitter float64 `protobuf:"fixed64,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// Time allowed for each attempt. Defaults to retryDelay.
+	AttemptTimeout *durationpb.Duration `protobuf:"bytes,6,opt,name=attemptTimeout,proto3" json:"attemptTimeout,omitempty"`
+	// Retry only on these errors. Retries on all of them if empty.
+	RetryOn []RetryableError `protobuf:"varint,7,rep,packed,name=retryOn,proto3,enum=messages.RetryableError" json:"retryOn,omitempty"`
 }
 
 func (x *RetryBehavior) Reset() {
@@ -413,96 +843,81 @@ func (x *RetryBehavior) GetRetryDelay() *durationpb.Duration {
 	return nil
 }
 
-type RegistrationParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CircuitFailureThreshold uint32               `protobuf:"varint,3,opt,name=circuitFailureThreshold,proto3" json:"circuitFailureThreshold,omitempty"`
-	DebounceDelay           *durationpb.Duration `protobuf:"bytes,4,opt,name=debounceDelay,proto3" json:"debounceDelay,omitempty"`
-	Retry                   *RetryBehavior       `protobuf:"bytes,5,opt,name=Retry,proto3" json:"Retry,omitempty"`
-}
-
-func (x *RegistrationParams) Reset() {
-	*x = RegistrationParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RetryBehavior) GetMaxDelay() *durationpb.Duration {
+	if x != nil {
+		return x.MaxDelay
 	}
+	return nil
 }
 
-func (x *RegistrationParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RegistrationParams) ProtoMessage() {}
-
-func (x *RegistrationParams) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RetryBehavior) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RegistrationParams.ProtoReflect.Descriptor instead.
-func (*RegistrationParams) Descriptor() ([]byte, []int) {
-	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{3}
+	return 0
 }
 
-func (x *RegistrationParams) GetCircuitFailureThreshold() uint32 {
+func (x *RetryBehavior) GetJitter() float64 {
 	if x != nil {
-		return x.CircuitFailureThreshold
+		return x.Jitter
 	}
 	return 0
 }
 
-func (x *RegistrationParams) GetDebounceDelay() *durationpb.Duration {
+func (x *RetryBehavior) GetAttemptTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.DebounceDelay
+		return x.AttemptTimeout
 	}
 	return nil
 }
 
-func (x *RegistrationParams) GetRetry() *RetryBehavior {
+func (x *RetryBehavior) GetRetryOn() []RetryableError {
 	if x != nil {
-		return x.Retry
+		return x.RetryOn
 	}
 	return nil
 }
 
-type RegistrationMsg struct {
+// Tunes the circuit breaker in front of each topic a client sends requests to.
+// Unset fields take the sidecar defaults.
+type CircuitBreakerParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header      *Header             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	ServiceName string              `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
-	RegParams   *RegistrationParams `protobuf:"bytes,3,opt,name=regParams,proto3" json:"regParams,omitempty"`
-}
-
-func (x *RegistrationMsg) Reset() {
-	*x = RegistrationMsg{}
+	// Number of probes allowed through at once while half-open.
+	HalfOpenMaxProbes uint32 `protobuf:"varint,1,opt,name=halfOpenMaxProbes,proto3" json:"halfOpenMaxProbes,omitempty"`
+	// Number of successful probes needed to close the circuit again.
+	SuccessThreshold uint32 `protobuf:"varint,2,opt,name=successThreshold,proto3" json:"successThreshold,omitempty"`
+	// How long the circuit stays open the first time. It doubles every
+	// time a probe fails, up to maxOpenTimeout.
+	OpenTimeout    *durationpb.Duration `protobuf:"bytes,3,opt,name=openTimeout,proto3" json:"openTimeout,omitempty"`
+	MaxOpenTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=maxOpenTimeout,proto3" json:"maxOpenTimeout,omitempty"`
+	// If set, the circuit also opens when the failure rate over the last
+	// failureRateWindow reaches failureRate, once at least
+	// failureRateMinRequests were sent in that window.
+	FailureRateWindow      *durationpb.Duration `protobuf:"bytes,5,opt,name=failureRateWindow,proto3" json:"failureRateWindow,omitempty"`
+	FailureRate            float64              `protobuf:"fixed64,6,opt,name=failureRate,proto3" json:"failureRate,omitempty"`
+	FailureRateMinRequests uint32               `protobuf:"varint,7,opt,name=failureRateMinRequests,proto3" json:"failureRateMinRequests,omitempty"`
+}
+
+func (x *CircuitBreakerParams) Reset() {
+	*x = CircuitBreakerParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[4]
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegistrationMsg) String() string {
+func (x *CircuitBreakerParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistrationMsg) ProtoMessage() {}
+func (*CircuitBreakerParams) ProtoMessage() {}
 
-func (x *RegistrationMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[4]
+func (x *CircuitBreakerParams) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,45 +928,135 @@ func (x *RegistrationMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistrationMsg.ProtoReflect.Descriptor instead.
-func (*RegistrationMsg) Descriptor() ([]byte, []int) {
-	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CircuitBreakerParams.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerParams) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *RegistrationMsg) GetHeader() *Header {
+func (x *CircuitBreakerParams) GetHalfOpenMaxProbes() uint32 {
 	if x != nil {
-		return x.Header
+		return x.HalfOpenMaxProbes
 	}
-	return nil
+	return 0
 }
 
-func (x *RegistrationMsg) GetServiceName() string {
+func (x *CircuitBreakerParams) GetSuccessThreshold() uint32 {
 	if x != nil {
-		return x.ServiceName
+		return x.SuccessThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *RegistrationMsg) GetRegParams() *RegistrationParams {
+func (x *CircuitBreakerParams) GetOpenTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.RegParams
+		return x.OpenTimeout
 	}
 	return nil
 }
 
-type RegistrationMsgResponse struct {
+func (x *CircuitBreakerParams) GetMaxOpenTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.MaxOpenTimeout
+	}
+	return nil
+}
+
+func (x *CircuitBreakerParams) GetFailureRateWindow() *durationpb.Duration {
+	if x != nil {
+		return x.FailureRateWindow
+	}
+	return nil
+}
+
+func (x *CircuitBreakerParams) GetFailureRate() float64 {
+	if x != nil {
+		return x.FailureRate
+	}
+	return 0
+}
+
+func (x *CircuitBreakerParams) GetFailureRateMinRequests() uint32 {
+	if x != nil {
+		return x.FailureRateMinRequests
+	}
+	return 0
+}
+
+type TopicDebounce struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header         *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	RspHeader      *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
-	Msg            string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	AssignedServId []byte          `protobuf:"bytes,4,opt,name=assignedServId,proto3" json:"assignedServId,omitempty"`
+	Mode DebounceMode `protobuf:"varint,1,opt,name=mode,proto3,enum=messages.DebounceMode" json:"mode,omitempty"`
+	// Defaults to the registered debounceDelay if not set.
+	Delay *durationpb.Duration `protobuf:"bytes,2,opt,name=delay,proto3" json:"delay,omitempty"`
 }
 
-func (x *RegistrationMsgResponse) Reset() {
-	*x = RegistrationMsgResponse{}
+func (x *TopicDebounce) Reset() {
+	*x = TopicDebounce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopicDebounce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicDebounce) ProtoMessage() {}
+
+func (x *TopicDebounce) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicDebounce.ProtoReflect.Descriptor instead.
+func (*TopicDebounce) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TopicDebounce) GetMode() DebounceMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DebounceMode_DEBOUNCE_FIRST
+}
+
+func (x *TopicDebounce) GetDelay() *durationpb.Duration {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+// Token bucket limit on the messages a client sends through the sidecar.
+type RateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Messages per second. A zero rate is unlimited.
+	Rate float64 `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// Messages allowed in a burst. Defaults to one second's worth.
+	Burst uint32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Messages over the limit wait for a token, up to maxQueued of them
+	// at a time and for no longer than maxWait. Anything else is rejected
+	// with RESOURCE_EXHAUSTED and a retry-after hint.
+	MaxQueued uint32               `protobuf:"varint,3,opt,name=maxQueued,proto3" json:"maxQueued,omitempty"`
+	MaxWait   *durationpb.Duration `protobuf:"bytes,4,opt,name=maxWait,proto3" json:"maxWait,omitempty"`
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,13 +1064,13 @@ func (x *RegistrationMsgResponse) Reset() {
 	}
 }
 
-func (x *RegistrationMsgResponse) String() string {
+func (x *RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegistrationMsgResponse) ProtoMessage() {}
+func (*RateLimit) ProtoMessage() {}
 
-func (x *RegistrationMsgResponse) ProtoReflect() protoreflect.Message {
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,53 +1082,66 @@ func (x *RegistrationMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegistrationMsgResponse.ProtoReflect.Descriptor instead.
-func (*RegistrationMsgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *RegistrationMsgResponse) GetHeader() *Header {
+func (x *RateLimit) GetRate() float64 {
 	if x != nil {
-		return x.Header
+		return x.Rate
 	}
-	return nil
+	return 0
 }
 
-func (x *RegistrationMsgResponse) GetRspHeader() *ResponseHeader {
+func (x *RateLimit) GetBurst() uint32 {
 	if x != nil {
-		return x.RspHeader
+		return x.Burst
 	}
-	return nil
+	return 0
 }
 
-func (x *RegistrationMsgResponse) GetMsg() string {
+func (x *RateLimit) GetMaxQueued() uint32 {
 	if x != nil {
-		return x.Msg
+		return x.MaxQueued
 	}
-	return ""
+	return 0
 }
 
-func (x *RegistrationMsgResponse) GetAssignedServId() []byte {
+func (x *RateLimit) GetMaxWait() *durationpb.Duration {
 	if x != nil {
-		return x.AssignedServId
+		return x.MaxWait
 	}
 	return nil
 }
 
-// Publish the given bytes on the given topic.
-type PubMsg struct {
+type RegistrationParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic  string         `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	Msg    []byte         `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	Retry  *RetryBehavior `protobuf:"bytes,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	CircuitFailureThreshold uint32               `protobuf:"varint,3,opt,name=circuitFailureThreshold,proto3" json:"circuitFailureThreshold,omitempty"`
+	DebounceDelay           *durationpb.Duration `protobuf:"bytes,4,opt,name=debounceDelay,proto3" json:"debounceDelay,omitempty"`
+	Retry                   *RetryBehavior       `protobuf:"bytes,5,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	// The client must send a message, or a KeepAlive, at least once
+	// every leaseTTL. If it does not, the sidecar deregisters it and
+	// removes all its subscriptions. A zero leaseTTL never expires.
+	LeaseTTL       *durationpb.Duration  `protobuf:"bytes,6,opt,name=leaseTTL,proto3" json:"leaseTTL,omitempty"`
+	CircuitBreaker *CircuitBreakerParams `protobuf:"bytes,7,opt,name=circuitBreaker,proto3" json:"circuitBreaker,omitempty"`
+	// Identical messages published to the same topic within debounceDelay
+	// are collapsed into one. debounceMode applies to all topics,
+	// unless topicDebounce has an entry for the topic.
+	DebounceMode  DebounceMode              `protobuf:"varint,8,opt,name=debounceMode,proto3,enum=messages.DebounceMode" json:"debounceMode,omitempty"`
+	TopicDebounce map[string]*TopicDebounce `protobuf:"bytes,9,rep,name=topicDebounce,proto3" json:"topicDebounce,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// rateLimit applies to everything the client publishes or logs.
+	// topicRateLimit applies to each topic separately. The sidecar-config
+	// can override topicRateLimit for some topics.
+	RateLimit      *RateLimit `protobuf:"bytes,10,opt,name=rateLimit,proto3" json:"rateLimit,omitempty"`
+	TopicRateLimit *RateLimit `protobuf:"bytes,11,opt,name=topicRateLimit,proto3" json:"topicRateLimit,omitempty"`
 }
 
-func (x *PubMsg) Reset() {
-	*x = PubMsg{}
+func (x *RegistrationParams) Reset() {
+	*x = RegistrationParams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,13 +1149,13 @@ func (x *PubMsg) Reset() {
 	}
 }
 
-func (x *PubMsg) String() string {
+func (x *RegistrationParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PubMsg) ProtoMessage() {}
+func (*RegistrationParams) ProtoMessage() {}
 
-func (x *PubMsg) ProtoReflect() protoreflect.Message {
+func (x *RegistrationParams) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,51 +1167,86 @@ func (x *PubMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PubMsg.ProtoReflect.Descriptor instead.
-func (*PubMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistrationParams.ProtoReflect.Descriptor instead.
+func (*RegistrationParams) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PubMsg) GetHeader() *Header {
+func (x *RegistrationParams) GetCircuitFailureThreshold() uint32 {
 	if x != nil {
-		return x.Header
+		return x.CircuitFailureThreshold
+	}
+	return 0
+}
+
+func (x *RegistrationParams) GetDebounceDelay() *durationpb.Duration {
+	if x != nil {
+		return x.DebounceDelay
 	}
 	return nil
 }
 
-func (x *PubMsg) GetTopic() string {
+func (x *RegistrationParams) GetRetry() *RetryBehavior {
 	if x != nil {
-		return x.Topic
+		return x.Retry
 	}
-	return ""
+	return nil
 }
 
-func (x *PubMsg) GetMsg() []byte {
+func (x *RegistrationParams) GetLeaseTTL() *durationpb.Duration {
 	if x != nil {
-		return x.Msg
+		return x.LeaseTTL
 	}
 	return nil
 }
 
-func (x *PubMsg) GetRetry() *RetryBehavior {
+func (x *RegistrationParams) GetCircuitBreaker() *CircuitBreakerParams {
 	if x != nil {
-		return x.Retry
+		return x.CircuitBreaker
 	}
 	return nil
 }
 
-type PubMsgResponse struct {
+func (x *RegistrationParams) GetDebounceMode() DebounceMode {
+	if x != nil {
+		return x.DebounceMode
+	}
+	return DebounceMode_DEBOUNCE_FIRST
+}
+
+func (x *RegistrationParams) GetTopicDebounce() map[string]*TopicDebounce {
+	if x != nil {
+		return x.TopicDebounce
+	}
+	return nil
+}
+
+func (x *RegistrationParams) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *RegistrationParams) GetTopicRateLimit() *RateLimit {
+	if x != nil {
+		return x.TopicRateLimit
+	}
+	return nil
+}
+
+type RegistrationMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
-	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Header      *Header             `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ServiceName string              `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	RegParams   *RegistrationParams `protobuf:"bytes,3,opt,name=regParams,proto3" json:"regParams,omitempty"`
 }
 
-func (x *PubMsgResponse) Reset() {
-	*x = PubMsgResponse{}
+func (x *RegistrationMsg) Reset() {
+	*x = RegistrationMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -701,13 +1254,13 @@ func (x *PubMsgResponse) Reset() {
 	}
 }
 
-func (x *PubMsgResponse) String() string {
+func (x *RegistrationMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PubMsgResponse) ProtoMessage() {}
+func (*RegistrationMsg) ProtoMessage() {}
 
-func (x *PubMsgResponse) ProtoReflect() protoreflect.Message {
+func (x *RegistrationMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -719,46 +1272,50 @@ func (x *PubMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PubMsgResponse.ProtoReflect.Descriptor instead.
-func (*PubMsgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistrationMsg.ProtoReflect.Descriptor instead.
+func (*RegistrationMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *PubMsgResponse) GetHeader() *Header {
+func (x *RegistrationMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *PubMsgResponse) GetRspHeader() *ResponseHeader {
+func (x *RegistrationMsg) GetServiceName() string {
 	if x != nil {
-		return x.RspHeader
+		return x.ServiceName
 	}
-	return nil
+	return ""
 }
 
-func (x *PubMsgResponse) GetMsg() string {
+func (x *RegistrationMsg) GetRegParams() *RegistrationParams {
 	if x != nil {
-		return x.Msg
+		return x.RegParams
 	}
-	return ""
+	return nil
 }
 
-type PubJSMsg struct {
+type RegistrationMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic     string         `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	WorkQueue string         `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
-	Msg       []byte         `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
-	Retry     *RetryBehavior `protobuf:"bytes,5,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	Header         *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader      *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg            string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	AssignedServId []byte          `protobuf:"bytes,4,opt,name=assignedServId,proto3" json:"assignedServId,omitempty"`
+	// Send the session token in the authorization metadata of every later
+	// call, as "Bearer <sessionToken>". It is bound to assignedServId and
+	// the service name, and KeepAlive hands out a new one before it expires.
+	SessionToken     string                 `protobuf:"bytes,5,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	SessionExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sessionExpiresAt,proto3" json:"sessionExpiresAt,omitempty"`
 }
 
-func (x *PubJSMsg) Reset() {
-	*x = PubJSMsg{}
+func (x *RegistrationMsgResponse) Reset() {
+	*x = RegistrationMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -766,13 +1323,13 @@ func (x *PubJSMsg) Reset() {
 	}
 }
 
-func (x *PubJSMsg) String() string {
+func (x *RegistrationMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PubJSMsg) ProtoMessage() {}
+func (*RegistrationMsgResponse) ProtoMessage() {}
 
-func (x *PubJSMsg) ProtoReflect() protoreflect.Message {
+func (x *RegistrationMsgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -784,59 +1341,63 @@ func (x *PubJSMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PubJSMsg.ProtoReflect.Descriptor instead.
-func (*PubJSMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistrationMsgResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationMsgResponse) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PubJSMsg) GetHeader() *Header {
+func (x *RegistrationMsgResponse) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *PubJSMsg) GetTopic() string {
+func (x *RegistrationMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
-		return x.Topic
+		return x.RspHeader
 	}
-	return ""
+	return nil
 }
 
-func (x *PubJSMsg) GetWorkQueue() string {
+func (x *RegistrationMsgResponse) GetMsg() string {
 	if x != nil {
-		return x.WorkQueue
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *PubJSMsg) GetMsg() []byte {
+func (x *RegistrationMsgResponse) GetAssignedServId() []byte {
 	if x != nil {
-		return x.Msg
+		return x.AssignedServId
 	}
 	return nil
 }
 
-func (x *PubJSMsg) GetRetry() *RetryBehavior {
+func (x *RegistrationMsgResponse) GetSessionToken() string {
 	if x != nil {
-		return x.Retry
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *RegistrationMsgResponse) GetSessionExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionExpiresAt
 	}
 	return nil
 }
 
-type PubJSMsgResponse struct {
+type DeregistrationMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
-	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-	WorkQueue string          `protobuf:"bytes,4,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (x *PubJSMsgResponse) Reset() {
-	*x = PubJSMsgResponse{}
+func (x *DeregistrationMsg) Reset() {
+	*x = DeregistrationMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,13 +1405,13 @@ func (x *PubJSMsgResponse) Reset() {
 	}
 }
 
-func (x *PubJSMsgResponse) String() string {
+func (x *DeregistrationMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PubJSMsgResponse) ProtoMessage() {}
+func (*DeregistrationMsg) ProtoMessage() {}
 
-func (x *PubJSMsgResponse) ProtoReflect() protoreflect.Message {
+func (x *DeregistrationMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -862,51 +1423,30 @@ func (x *PubJSMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PubJSMsgResponse.ProtoReflect.Descriptor instead.
-func (*PubJSMsgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeregistrationMsg.ProtoReflect.Descriptor instead.
+func (*DeregistrationMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *PubJSMsgResponse) GetHeader() *Header {
+func (x *DeregistrationMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *PubJSMsgResponse) GetRspHeader() *ResponseHeader {
-	if x != nil {
-		return x.RspHeader
-	}
-	return nil
-}
-
-func (x *PubJSMsgResponse) GetMsg() string {
-	if x != nil {
-		return x.Msg
-	}
-	return ""
-}
-
-func (x *PubJSMsgResponse) GetWorkQueue() string {
-	if x != nil {
-		return x.WorkQueue
-	}
-	return ""
-}
-
-type SubMsg struct {
+type DeregistrationMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header   *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic    string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	ChanSize uint32  `protobuf:"varint,3,opt,name=chanSize,proto3" json:"chanSize,omitempty"`
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *SubMsg) Reset() {
-	*x = SubMsg{}
+func (x *DeregistrationMsgResponse) Reset() {
+	*x = DeregistrationMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,13 +1454,13 @@ func (x *SubMsg) Reset() {
 	}
 }
 
-func (x *SubMsg) String() string {
+func (x *DeregistrationMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubMsg) ProtoMessage() {}
+func (*DeregistrationMsgResponse) ProtoMessage() {}
 
-func (x *SubMsg) ProtoReflect() protoreflect.Message {
+func (x *DeregistrationMsgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,44 +1472,42 @@ func (x *SubMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubMsg.ProtoReflect.Descriptor instead.
-func (*SubMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeregistrationMsgResponse.ProtoReflect.Descriptor instead.
+func (*DeregistrationMsgResponse) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SubMsg) GetHeader() *Header {
+func (x *DeregistrationMsgResponse) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *SubMsg) GetTopic() string {
+func (x *DeregistrationMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
-		return x.Topic
+		return x.RspHeader
 	}
-	return ""
+	return nil
 }
 
-func (x *SubMsg) GetChanSize() uint32 {
+func (x *DeregistrationMsgResponse) GetMsg() string {
 	if x != nil {
-		return x.ChanSize
+		return x.Msg
 	}
-	return 0
+	return ""
 }
 
-type SubMsgResponse struct {
+type KeepAliveMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
-	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (x *SubMsgResponse) Reset() {
-	*x = SubMsgResponse{}
+func (x *KeepAliveMsg) Reset() {
+	*x = KeepAliveMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,13 +1515,13 @@ func (x *SubMsgResponse) Reset() {
 	}
 }
 
-func (x *SubMsgResponse) String() string {
+func (x *KeepAliveMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubMsgResponse) ProtoMessage() {}
+func (*KeepAliveMsg) ProtoMessage() {}
 
-func (x *SubMsgResponse) ProtoReflect() protoreflect.Message {
+func (x *KeepAliveMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -995,43 +1533,34 @@ func (x *SubMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubMsgResponse.ProtoReflect.Descriptor instead.
-func (*SubMsgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeepAliveMsg.ProtoReflect.Descriptor instead.
+func (*KeepAliveMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SubMsgResponse) GetHeader() *Header {
+func (x *KeepAliveMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *SubMsgResponse) GetRspHeader() *ResponseHeader {
-	if x != nil {
-		return x.RspHeader
-	}
-	return nil
-}
-
-func (x *SubMsgResponse) GetMsg() string {
-	if x != nil {
-		return x.Msg
-	}
-	return ""
-}
-
-type UnsubMsg struct {
+type KeepAliveMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic  string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Header    *Header              `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader      `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string               `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	LeaseTTL  *durationpb.Duration `protobuf:"bytes,4,opt,name=leaseTTL,proto3" json:"leaseTTL,omitempty"`
+	// A fresh session token, to use in place of the old one.
+	SessionToken     string                 `protobuf:"bytes,5,opt,name=sessionToken,proto3" json:"sessionToken,omitempty"`
+	SessionExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sessionExpiresAt,proto3" json:"sessionExpiresAt,omitempty"`
 }
 
-func (x *UnsubMsg) Reset() {
-	*x = UnsubMsg{}
+func (x *KeepAliveMsgResponse) Reset() {
+	*x = KeepAliveMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1039,13 +1568,13 @@ func (x *UnsubMsg) Reset() {
 	}
 }
 
-func (x *UnsubMsg) String() string {
+func (x *KeepAliveMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubMsg) ProtoMessage() {}
+func (*KeepAliveMsgResponse) ProtoMessage() {}
 
-func (x *UnsubMsg) ProtoReflect() protoreflect.Message {
+func (x *KeepAliveMsgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1057,37 +1586,69 @@ func (x *UnsubMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubMsg.ProtoReflect.Descriptor instead.
-func (*UnsubMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use KeepAliveMsgResponse.ProtoReflect.Descriptor instead.
+func (*KeepAliveMsgResponse) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UnsubMsg) GetHeader() *Header {
+func (x *KeepAliveMsgResponse) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *UnsubMsg) GetTopic() string {
+func (x *KeepAliveMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
-		return x.Topic
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *KeepAliveMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
 	}
 	return ""
 }
 
-type UnsubMsgResponse struct {
+func (x *KeepAliveMsgResponse) GetLeaseTTL() *durationpb.Duration {
+	if x != nil {
+		return x.LeaseTTL
+	}
+	return nil
+}
+
+func (x *KeepAliveMsgResponse) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *KeepAliveMsgResponse) GetSessionExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SessionExpiresAt
+	}
+	return nil
+}
+
+// A service registered with some sidecar.
+type ServiceInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
-	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	ServId       []byte                 `protobuf:"bytes,1,opt,name=servId,proto3" json:"servId,omitempty"`
+	ServiceName  string                 `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	SidecarId    []byte                 `protobuf:"bytes,3,opt,name=sidecarId,proto3" json:"sidecarId,omitempty"`
+	SidecarAddr  string                 `protobuf:"bytes,4,opt,name=sidecarAddr,proto3" json:"sidecarAddr,omitempty"`
+	RegisteredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=registeredAt,proto3" json:"registeredAt,omitempty"`
+	LastSeen     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
 }
 
-func (x *UnsubMsgResponse) Reset() {
-	*x = UnsubMsgResponse{}
+func (x *ServiceInstance) Reset() {
+	*x = ServiceInstance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,13 +1656,13 @@ func (x *UnsubMsgResponse) Reset() {
 	}
 }
 
-func (x *UnsubMsgResponse) String() string {
+func (x *ServiceInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubMsgResponse) ProtoMessage() {}
+func (*ServiceInstance) ProtoMessage() {}
 
-func (x *UnsubMsgResponse) ProtoReflect() protoreflect.Message {
+func (x *ServiceInstance) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1113,44 +1674,67 @@ func (x *UnsubMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubMsgResponse.ProtoReflect.Descriptor instead.
-func (*UnsubMsgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceInstance.ProtoReflect.Descriptor instead.
+func (*ServiceInstance) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UnsubMsgResponse) GetHeader() *Header {
+func (x *ServiceInstance) GetServId() []byte {
 	if x != nil {
-		return x.Header
+		return x.ServId
 	}
 	return nil
 }
 
-func (x *UnsubMsgResponse) GetRspHeader() *ResponseHeader {
+func (x *ServiceInstance) GetServiceName() string {
 	if x != nil {
-		return x.RspHeader
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ServiceInstance) GetSidecarId() []byte {
+	if x != nil {
+		return x.SidecarId
 	}
 	return nil
 }
 
-func (x *UnsubMsgResponse) GetMsg() string {
+func (x *ServiceInstance) GetSidecarAddr() string {
 	if x != nil {
-		return x.Msg
+		return x.SidecarAddr
 	}
 	return ""
 }
 
-type UnsubJSMsg struct {
+func (x *ServiceInstance) GetRegisteredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return nil
+}
+
+func (x *ServiceInstance) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+// Sent by each sidecar over NATS, both periodically and whenever its
+// services change. It is also the reply to a DiscoveryQuery.
+type ServiceAnnouncement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	SidecarId   []byte             `protobuf:"bytes,1,opt,name=sidecarId,proto3" json:"sidecarId,omitempty"`
+	SidecarAddr string             `protobuf:"bytes,2,opt,name=sidecarAddr,proto3" json:"sidecarAddr,omitempty"`
+	Instances   []*ServiceInstance `protobuf:"bytes,3,rep,name=instances,proto3" json:"instances,omitempty"`
 }
 
-func (x *UnsubJSMsg) Reset() {
-	*x = UnsubJSMsg{}
+func (x *ServiceAnnouncement) Reset() {
+	*x = ServiceAnnouncement{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1158,13 +1742,13 @@ func (x *UnsubJSMsg) Reset() {
 	}
 }
 
-func (x *UnsubJSMsg) String() string {
+func (x *ServiceAnnouncement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubJSMsg) ProtoMessage() {}
+func (*ServiceAnnouncement) ProtoMessage() {}
 
-func (x *UnsubJSMsg) ProtoReflect() protoreflect.Message {
+func (x *ServiceAnnouncement) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1176,44 +1760,44 @@ func (x *UnsubJSMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubJSMsg.ProtoReflect.Descriptor instead.
-func (*UnsubJSMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServiceAnnouncement.ProtoReflect.Descriptor instead.
+func (*ServiceAnnouncement) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UnsubJSMsg) GetHeader() *Header {
+func (x *ServiceAnnouncement) GetSidecarId() []byte {
 	if x != nil {
-		return x.Header
+		return x.SidecarId
 	}
 	return nil
 }
 
-func (x *UnsubJSMsg) GetTopic() string {
+func (x *ServiceAnnouncement) GetSidecarAddr() string {
 	if x != nil {
-		return x.Topic
+		return x.SidecarAddr
 	}
 	return ""
 }
 
-func (x *UnsubJSMsg) GetWorkQueue() string {
+func (x *ServiceAnnouncement) GetInstances() []*ServiceInstance {
 	if x != nil {
-		return x.WorkQueue
+		return x.Instances
 	}
-	return ""
+	return nil
 }
 
-type UnsubJSMsgResponse struct {
+// Sent between sidecars over NATS by a sidecar that just started, to hear
+// from those that started before it. An empty serviceName matches all services.
+type DiscoveryQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
-	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	ServiceName string `protobuf:"bytes,1,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
 }
 
-func (x *UnsubJSMsgResponse) Reset() {
-	*x = UnsubJSMsgResponse{}
+func (x *DiscoveryQuery) Reset() {
+	*x = DiscoveryQuery{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1221,13 +1805,13 @@ func (x *UnsubJSMsgResponse) Reset() {
 	}
 }
 
-func (x *UnsubJSMsgResponse) String() string {
+func (x *DiscoveryQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubJSMsgResponse) ProtoMessage() {}
+func (*DiscoveryQuery) ProtoMessage() {}
 
-func (x *UnsubJSMsgResponse) ProtoReflect() protoreflect.Message {
+func (x *DiscoveryQuery) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1239,43 +1823,29 @@ func (x *UnsubJSMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubJSMsgResponse.ProtoReflect.Descriptor instead.
-func (*UnsubJSMsgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscoveryQuery.ProtoReflect.Descriptor instead.
+func (*DiscoveryQuery) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UnsubJSMsgResponse) GetHeader() *Header {
-	if x != nil {
-		return x.Header
-	}
-	return nil
-}
-
-func (x *UnsubJSMsgResponse) GetRspHeader() *ResponseHeader {
-	if x != nil {
-		return x.RspHeader
-	}
-	return nil
-}
-
-func (x *UnsubJSMsgResponse) GetMsg() string {
+func (x *DiscoveryQuery) GetServiceName() string {
 	if x != nil {
-		return x.Msg
+		return x.ServiceName
 	}
 	return ""
 }
 
-type Receive struct {
+type DiscoverMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic  string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Header      *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ServiceName string  `protobuf:"bytes,2,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
 }
 
-func (x *Receive) Reset() {
-	*x = Receive{}
+func (x *DiscoverMsg) Reset() {
+	*x = DiscoverMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1283,13 +1853,13 @@ func (x *Receive) Reset() {
 	}
 }
 
-func (x *Receive) String() string {
+func (x *DiscoverMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Receive) ProtoMessage() {}
+func (*DiscoverMsg) ProtoMessage() {}
 
-func (x *Receive) ProtoReflect() protoreflect.Message {
+func (x *DiscoverMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1301,37 +1871,38 @@ func (x *Receive) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Receive.ProtoReflect.Descriptor instead.
-func (*Receive) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscoverMsg.ProtoReflect.Descriptor instead.
+func (*DiscoverMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Receive) GetHeader() *Header {
+func (x *DiscoverMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *Receive) GetTopic() string {
+func (x *DiscoverMsg) GetServiceName() string {
 	if x != nil {
-		return x.Topic
+		return x.ServiceName
 	}
 	return ""
 }
 
-type ReceiveJS struct {
+type DiscoverMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	Header    *Header            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader    `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string             `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Instances []*ServiceInstance `protobuf:"bytes,4,rep,name=instances,proto3" json:"instances,omitempty"`
 }
 
-func (x *ReceiveJS) Reset() {
-	*x = ReceiveJS{}
+func (x *DiscoverMsgResponse) Reset() {
+	*x = DiscoverMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1339,13 +1910,13 @@ func (x *ReceiveJS) Reset() {
 	}
 }
 
-func (x *ReceiveJS) String() string {
+func (x *DiscoverMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveJS) ProtoMessage() {}
+func (*DiscoverMsgResponse) ProtoMessage() {}
 
-func (x *ReceiveJS) ProtoReflect() protoreflect.Message {
+func (x *DiscoverMsgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1357,46 +1928,62 @@ func (x *ReceiveJS) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveJS.ProtoReflect.Descriptor instead.
-func (*ReceiveJS) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscoverMsgResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverMsgResponse) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ReceiveJS) GetHeader() *Header {
+func (x *DiscoverMsgResponse) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *ReceiveJS) GetTopic() string {
+func (x *DiscoverMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
-		return x.Topic
+		return x.RspHeader
 	}
-	return ""
+	return nil
 }
 
-func (x *ReceiveJS) GetWorkQueue() string {
+func (x *DiscoverMsgResponse) GetMsg() string {
 	if x != nil {
-		return x.WorkQueue
+		return x.Msg
 	}
 	return ""
 }
 
-// Change this to SubTopicMsg. This is the message coming from NATS server
-// for the specific topic to which this sidecar has subscribed.
-type SubTopicResponse struct {
+func (x *DiscoverMsgResponse) GetInstances() []*ServiceInstance {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// Publish the given bytes on the given topic. Does not wait for
+// subscribers, only for the NATS server to receive the message.
+type PubMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic  string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	Msg    []byte  `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Header *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic  string         `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Msg    []byte         `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Retry  *RetryBehavior `protobuf:"bytes,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	// Messages with the same key to the same topic are published only once
+	// within the sidecar's dedupe window. Empty means no deduplication.
+	// Keys are shared by all clients of the sidecar, so that a client that
+	// registered again is still deduplicated. Clients that must not collide
+	// should put something of their own in their keys.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	// Sent as the NATS message headers.
+	Headers map[string]*HeaderValues `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SubTopicResponse) Reset() {
-	*x = SubTopicResponse{}
+func (x *PubMsg) Reset() {
+	*x = PubMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1404,13 +1991,13 @@ func (x *SubTopicResponse) Reset() {
 	}
 }
 
-func (x *SubTopicResponse) String() string {
+func (x *PubMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubTopicResponse) ProtoMessage() {}
+func (*PubMsg) ProtoMessage() {}
 
-func (x *SubTopicResponse) ProtoReflect() protoreflect.Message {
+func (x *PubMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1422,45 +2009,72 @@ func (x *SubTopicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubTopicResponse.ProtoReflect.Descriptor instead.
-func (*SubTopicResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PubMsg.ProtoReflect.Descriptor instead.
+func (*PubMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SubTopicResponse) GetHeader() *Header {
+func (x *PubMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *SubTopicResponse) GetTopic() string {
+func (x *PubMsg) GetTopic() string {
 	if x != nil {
 		return x.Topic
 	}
 	return ""
 }
 
-func (x *SubTopicResponse) GetMsg() []byte {
+func (x *PubMsg) GetMsg() []byte {
 	if x != nil {
 		return x.Msg
 	}
 	return nil
 }
 
-type SubJSTopicResponse struct {
+func (x *PubMsg) GetRetry() *RetryBehavior {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *PubMsg) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PubMsg) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type PubMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
-	Msg       []byte  `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// This message was collapsed into an identical one, and not published itself.
+	Debounced bool `protobuf:"varint,4,opt,name=debounced,proto3" json:"debounced,omitempty"`
+	// Number of identical messages collapsed into this one.
+	Collapsed uint32 `protobuf:"varint,5,opt,name=collapsed,proto3" json:"collapsed,omitempty"`
+	// A message with the same idempotencyKey was already published
+	// within the dedupe window, so this one was not.
+	Duplicate bool `protobuf:"varint,6,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
 }
 
-func (x *SubJSTopicResponse) Reset() {
-	*x = SubJSTopicResponse{}
+func (x *PubMsgResponse) Reset() {
+	*x = PubMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1468,13 +2082,13 @@ func (x *SubJSTopicResponse) Reset() {
 	}
 }
 
-func (x *SubJSTopicResponse) String() string {
+func (x *PubMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubJSTopicResponse) ProtoMessage() {}
+func (*PubMsgResponse) ProtoMessage() {}
 
-func (x *SubJSTopicResponse) ProtoReflect() protoreflect.Message {
+func (x *PubMsgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1486,50 +2100,70 @@ func (x *SubJSTopicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubJSTopicResponse.ProtoReflect.Descriptor instead.
-func (*SubJSTopicResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PubMsgResponse.ProtoReflect.Descriptor instead.
+func (*PubMsgResponse) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SubJSTopicResponse) GetHeader() *Header {
+func (x *PubMsgResponse) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *SubJSTopicResponse) GetTopic() string {
+func (x *PubMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
-		return x.Topic
+		return x.RspHeader
 	}
-	return ""
+	return nil
 }
 
-func (x *SubJSTopicResponse) GetWorkQueue() string {
+func (x *PubMsgResponse) GetMsg() string {
 	if x != nil {
-		return x.WorkQueue
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *SubJSTopicResponse) GetMsg() []byte {
+func (x *PubMsgResponse) GetDebounced() bool {
 	if x != nil {
-		return x.Msg
+		return x.Debounced
 	}
-	return nil
+	return false
 }
 
-type LogMsg struct {
+func (x *PubMsgResponse) GetCollapsed() uint32 {
+	if x != nil {
+		return x.Collapsed
+	}
+	return 0
+}
+
+func (x *PubMsgResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+// Send the given bytes on the given topic, and wait for a reply.
+type RequestMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Msg    string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Header *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic  string         `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Msg    []byte         `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	Retry  *RetryBehavior `protobuf:"bytes,4,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	// Time allowed for the whole request, including retries.
+	// If not set, only the gRPC deadline applies.
+	Timeout *durationpb.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *LogMsg) Reset() {
-	*x = LogMsg{}
+func (x *RequestMsg) Reset() {
+	*x = RequestMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,13 +2171,13 @@ func (x *LogMsg) Reset() {
 	}
 }
 
-func (x *LogMsg) String() string {
+func (x *RequestMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogMsg) ProtoMessage() {}
+func (*RequestMsg) ProtoMessage() {}
 
-func (x *LogMsg) ProtoReflect() protoreflect.Message {
+func (x *RequestMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,26 +2189,47 @@ func (x *LogMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogMsg.ProtoReflect.Descriptor instead.
-func (*LogMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestMsg.ProtoReflect.Descriptor instead.
+func (*RequestMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *LogMsg) GetHeader() *Header {
+func (x *RequestMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *LogMsg) GetMsg() string {
+func (x *RequestMsg) GetTopic() string {
 	if x != nil {
-		return x.Msg
+		return x.Topic
 	}
 	return ""
 }
 
-type LogMsgResponse struct {
+func (x *RequestMsg) GetMsg() []byte {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *RequestMsg) GetRetry() *RetryBehavior {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *RequestMsg) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type RequestMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1582,10 +2237,19 @@ type LogMsgResponse struct {
 	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
 	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
-}
-
-func (x *LogMsgResponse) Reset() {
-	*x = LogMsgResponse{}
+	Reply     []byte          `protobuf:"bytes,4,opt,name=reply,proto3" json:"reply,omitempty"`
+	// Set with ERR_CIRCUIT_OPEN. The sidecar will not try
+	// this topic again until this much time has passed.
+	RetryAfter *durationpb.Duration `protobuf:"bytes,5,opt,name=retryAfter,proto3" json:"retryAfter,omitempty"`
+	// This request was collapsed into an identical one, and not sent itself.
+	// The reply is the one to that request.
+	Debounced bool `protobuf:"varint,6,opt,name=debounced,proto3" json:"debounced,omitempty"`
+	// Number of identical requests collapsed into this one.
+	Collapsed uint32 `protobuf:"varint,7,opt,name=collapsed,proto3" json:"collapsed,omitempty"`
+}
+
+func (x *RequestMsgResponse) Reset() {
+	*x = RequestMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1593,13 +2257,13 @@ func (x *LogMsgResponse) Reset() {
 	}
 }
 
-func (x *LogMsgResponse) String() string {
+func (x *RequestMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogMsgResponse) ProtoMessage() {}
+func (*RequestMsgResponse) ProtoMessage() {}
 
-func (x *LogMsgResponse) ProtoReflect() protoreflect.Message {
+func (x *RequestMsgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1611,52 +2275,74 @@ func (x *LogMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogMsgResponse.ProtoReflect.Descriptor instead.
-func (*LogMsgResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestMsgResponse.ProtoReflect.Descriptor instead.
+func (*RequestMsgResponse) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *LogMsgResponse) GetHeader() *Header {
+func (x *RequestMsgResponse) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *LogMsgResponse) GetRspHeader() *ResponseHeader {
+func (x *RequestMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
 		return x.RspHeader
 	}
 	return nil
 }
 
-func (x *LogMsgResponse) GetMsg() string {
+func (x *RequestMsgResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-type Doc struct {
+func (x *RequestMsgResponse) GetReply() []byte {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *RequestMsgResponse) GetRetryAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return nil
+}
+
+func (x *RequestMsgResponse) GetDebounced() bool {
+	if x != nil {
+		return x.Debounced
+	}
+	return false
+}
+
+func (x *RequestMsgResponse) GetCollapsed() uint32 {
+	if x != nil {
+		return x.Collapsed
+	}
+	return 0
+}
+
+// What JetStream says once it stored a message.
+type PubAck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DocId      uint64   `protobuf:"varint,1,opt,name=docId,proto3" json:"docId,omitempty"`
-	WordInts   []uint64 `protobuf:"varint,2,rep,packed,name=wordInts,proto3" json:"wordInts,omitempty"`
-	InputDocId string   `protobuf:"bytes,3,opt,name=inputDocId,proto3" json:"inputDocId,omitempty"`
-	UserId     string   `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
-	BusinessId string   `protobuf:"bytes,5,opt,name=businessId,proto3" json:"businessId,omitempty"`
-	Stars      float32  `protobuf:"fixed32,6,opt,name=stars,proto3" json:"stars,omitempty"`
-	Useful     uint32   `protobuf:"varint,7,opt,name=useful,proto3" json:"useful,omitempty"`
-	Funny      uint32   `protobuf:"varint,8,opt,name=funny,proto3" json:"funny,omitempty"`
-	Cool       uint32   `protobuf:"varint,9,opt,name=cool,proto3" json:"cool,omitempty"`
-	Text       string   `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
-	Date       string   `protobuf:"bytes,11,opt,name=date,proto3" json:"date,omitempty"`
+	Stream   string `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The message had the ID of one stored earlier, so it was not stored again.
+	Duplicate bool `protobuf:"varint,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
 }
 
-func (x *Doc) Reset() {
-	*x = Doc{}
+func (x *PubAck) Reset() {
+	*x = PubAck{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1664,13 +2350,13 @@ func (x *Doc) Reset() {
 	}
 }
 
-func (x *Doc) String() string {
+func (x *PubAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Doc) ProtoMessage() {}
+func (*PubAck) ProtoMessage() {}
 
-func (x *Doc) ProtoReflect() protoreflect.Message {
+func (x *PubAck) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1682,113 +2368,172 @@ func (x *Doc) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Doc.ProtoReflect.Descriptor instead.
-func (*Doc) Descriptor() ([]byte, []int) {
+// Deprecated: Use PubAck.ProtoReflect.Descriptor instead.
+func (*PubAck) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Doc) GetDocId() uint64 {
+func (x *PubAck) GetStream() string {
 	if x != nil {
-		return x.DocId
+		return x.Stream
 	}
-	return 0
+	return ""
 }
 
-func (x *Doc) GetWordInts() []uint64 {
+func (x *PubAck) GetSequence() uint64 {
 	if x != nil {
-		return x.WordInts
+		return x.Sequence
 	}
-	return nil
+	return 0
 }
 
-func (x *Doc) GetInputDocId() string {
+func (x *PubAck) GetDuplicate() bool {
 	if x != nil {
-		return x.InputDocId
+		return x.Duplicate
 	}
-	return ""
+	return false
 }
 
-func (x *Doc) GetUserId() string {
+type PubJSMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic     string         `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	WorkQueue string         `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	Msg       []byte         `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Retry     *RetryBehavior `protobuf:"bytes,5,opt,name=Retry,proto3" json:"Retry,omitempty"`
+	AckMode   PubAckMode     `protobuf:"varint,6,opt,name=ackMode,proto3,enum=messages.PubAckMode" json:"ackMode,omitempty"`
+	// Sent as the Nats-Msg-Id, so that JetStream stores messages with the
+	// same key only once within the stream's duplicate window. As with
+	// PubMsg, keys are shared by everyone publishing to the stream.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	// Sent as the NATS message headers. The idempotencyKey takes the
+	// place of any Nats-Msg-Id among them.
+	Headers map[string]*HeaderValues `protobuf:"bytes,8,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PubJSMsg) Reset() {
+	*x = PubJSMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubJSMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubJSMsg) ProtoMessage() {}
+
+func (x *PubJSMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubJSMsg.ProtoReflect.Descriptor instead.
+func (*PubJSMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PubJSMsg) GetHeader() *Header {
 	if x != nil {
-		return x.UserId
+		return x.Header
 	}
-	return ""
+	return nil
 }
 
-func (x *Doc) GetBusinessId() string {
+func (x *PubJSMsg) GetTopic() string {
 	if x != nil {
-		return x.BusinessId
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *Doc) GetStars() float32 {
+func (x *PubJSMsg) GetWorkQueue() string {
 	if x != nil {
-		return x.Stars
+		return x.WorkQueue
 	}
-	return 0
+	return ""
 }
 
-func (x *Doc) GetUseful() uint32 {
+func (x *PubJSMsg) GetMsg() []byte {
 	if x != nil {
-		return x.Useful
+		return x.Msg
 	}
-	return 0
+	return nil
 }
 
-func (x *Doc) GetFunny() uint32 {
+func (x *PubJSMsg) GetRetry() *RetryBehavior {
 	if x != nil {
-		return x.Funny
+		return x.Retry
 	}
-	return 0
+	return nil
 }
 
-func (x *Doc) GetCool() uint32 {
+func (x *PubJSMsg) GetAckMode() PubAckMode {
 	if x != nil {
-		return x.Cool
+		return x.AckMode
 	}
-	return 0
+	return PubAckMode_PUB_ACK_SYNC
 }
 
-func (x *Doc) GetText() string {
+func (x *PubJSMsg) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Text
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-func (x *Doc) GetDate() string {
+func (x *PubJSMsg) GetHeaders() map[string]*HeaderValues {
 	if x != nil {
-		return x.Date
+		return x.Headers
 	}
-	return ""
+	return nil
 }
 
-type Documents struct {
+type PubJSMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Doc []*Doc `protobuf:"bytes,1,rep,name=doc,proto3" json:"doc,omitempty"`
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	WorkQueue string          `protobuf:"bytes,4,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	// PUB_ACK_SYNC only.
+	PubAck *PubAck `protobuf:"bytes,5,opt,name=pubAck,proto3" json:"pubAck,omitempty"`
+	// PUB_ACK_ASYNC only.
+	PubId uint64 `protobuf:"varint,6,opt,name=pubId,proto3" json:"pubId,omitempty"`
 }
 
-func (x *Documents) Reset() {
-	*x = Documents{}
+func (x *PubJSMsgResponse) Reset() {
+	*x = PubJSMsgResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[23]
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Documents) String() string {
+func (x *PubJSMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Documents) ProtoMessage() {}
+func (*PubJSMsgResponse) ProtoMessage() {}
 
-func (x *Documents) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[23]
+func (x *PubJSMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,76 +2544,64 @@ func (x *Documents) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Documents.ProtoReflect.Descriptor instead.
-func (*Documents) Descriptor() ([]byte, []int) {
-	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PubJSMsgResponse.ProtoReflect.Descriptor instead.
+func (*PubJSMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Documents) GetDoc() []*Doc {
+func (x *PubJSMsgResponse) GetHeader() *Header {
 	if x != nil {
-		return x.Doc
+		return x.Header
 	}
 	return nil
 }
 
-type StreamControl struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Flow StreamFlow `protobuf:"varint,1,opt,name=flow,proto3,enum=messages.StreamFlow" json:"flow,omitempty"`
-}
-
-func (x *StreamControl) Reset() {
-	*x = StreamControl{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PubJSMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
 	}
+	return nil
 }
 
-func (x *StreamControl) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PubJSMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
 }
 
-func (*StreamControl) ProtoMessage() {}
-
-func (x *StreamControl) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PubJSMsgResponse) GetWorkQueue() string {
+	if x != nil {
+		return x.WorkQueue
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use StreamControl.ProtoReflect.Descriptor instead.
-func (*StreamControl) Descriptor() ([]byte, []int) {
-	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{24}
+func (x *PubJSMsgResponse) GetPubAck() *PubAck {
+	if x != nil {
+		return x.PubAck
+	}
+	return nil
 }
 
-func (x *StreamControl) GetFlow() StreamFlow {
+func (x *PubJSMsgResponse) GetPubId() uint64 {
 	if x != nil {
-		return x.Flow
+		return x.PubId
 	}
-	return StreamFlow_OFF
+	return 0
 }
 
-type DocDownload struct {
+// Streams the results of the client's PUB_ACK_ASYNC publishes.
+type PubAcksMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Documents *Documents `protobuf:"bytes,1,opt,name=documents,proto3" json:"documents,omitempty"`
-	MsgNumber uint64     `protobuf:"varint,2,opt,name=msgNumber,proto3" json:"msgNumber,omitempty"`
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 }
 
-func (x *DocDownload) Reset() {
-	*x = DocDownload{}
+func (x *PubAcksMsg) Reset() {
+	*x = PubAcksMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1876,13 +2609,13 @@ func (x *DocDownload) Reset() {
 	}
 }
 
-func (x *DocDownload) String() string {
+func (x *PubAcksMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocDownload) ProtoMessage() {}
+func (*PubAcksMsg) ProtoMessage() {}
 
-func (x *DocDownload) ProtoReflect() protoreflect.Message {
+func (x *PubAcksMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1894,36 +2627,34 @@ func (x *DocDownload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocDownload.ProtoReflect.Descriptor instead.
-func (*DocDownload) Descriptor() ([]byte, []int) {
+// Deprecated: Use PubAcksMsg.ProtoReflect.Descriptor instead.
+func (*PubAcksMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DocDownload) GetDocuments() *Documents {
+func (x *PubAcksMsg) GetHeader() *Header {
 	if x != nil {
-		return x.Documents
+		return x.Header
 	}
 	return nil
 }
 
-func (x *DocDownload) GetMsgNumber() uint64 {
-	if x != nil {
-		return x.MsgNumber
-	}
-	return 0
-}
-
-type DocDownloadResponse struct {
+type PubAckResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Control      *StreamControl `protobuf:"bytes,1,opt,name=control,proto3" json:"control,omitempty"`
-	AckMsgNumber uint64         `protobuf:"varint,2,opt,name=ackMsgNumber,proto3" json:"ackMsgNumber,omitempty"`
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	PubId  uint64  `protobuf:"varint,2,opt,name=pubId,proto3" json:"pubId,omitempty"`
+	PubAck *PubAck `protobuf:"bytes,3,opt,name=pubAck,proto3" json:"pubAck,omitempty"`
+	// Set if the message may not have been stored.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Results dropped since the last one sent, because nobody read them.
+	Dropped uint64 `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
 }
 
-func (x *DocDownloadResponse) Reset() {
-	*x = DocDownloadResponse{}
+func (x *PubAckResult) Reset() {
+	*x = PubAckResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1931,13 +2662,13 @@ func (x *DocDownloadResponse) Reset() {
 	}
 }
 
-func (x *DocDownloadResponse) String() string {
+func (x *PubAckResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocDownloadResponse) ProtoMessage() {}
+func (*PubAckResult) ProtoMessage() {}
 
-func (x *DocDownloadResponse) ProtoReflect() protoreflect.Message {
+func (x *PubAckResult) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1949,36 +2680,69 @@ func (x *DocDownloadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocDownloadResponse.ProtoReflect.Descriptor instead.
-func (*DocDownloadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PubAckResult.ProtoReflect.Descriptor instead.
+func (*PubAckResult) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DocDownloadResponse) GetControl() *StreamControl {
+func (x *PubAckResult) GetHeader() *Header {
 	if x != nil {
-		return x.Control
+		return x.Header
 	}
 	return nil
 }
 
-func (x *DocDownloadResponse) GetAckMsgNumber() uint64 {
+func (x *PubAckResult) GetPubId() uint64 {
 	if x != nil {
-		return x.AckMsgNumber
+		return x.PubId
 	}
 	return 0
 }
 
-type DocUpload struct {
+func (x *PubAckResult) GetPubAck() *PubAck {
+	if x != nil {
+		return x.PubAck
+	}
+	return nil
+}
+
+func (x *PubAckResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PubAckResult) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type SubMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Documents *Documents `protobuf:"bytes,1,opt,name=documents,proto3" json:"documents,omitempty"`
-	MsgNumber uint64     `protobuf:"varint,2,opt,name=msgNumber,proto3" json:"msgNumber,omitempty"`
+	Header   *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic    string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	ChanSize uint32  `protobuf:"varint,3,opt,name=chanSize,proto3" json:"chanSize,omitempty"`
+	// Subscribers in the same queue group share the messages on the topic.
+	// Each message goes to only one of them.
+	QueueGroup string `protobuf:"bytes,4,opt,name=queueGroup,proto3" json:"queueGroup,omitempty"`
+	// Only used by the Subscribe stream. Stream the messages of a
+	// subscription made earlier with Sub, instead of subscribing again.
+	SubId uint64 `protobuf:"varint,5,opt,name=subId,proto3" json:"subId,omitempty"`
+	// chanSize messages are buffered in memory. overflow says what happens
+	// to messages after that. spillMaxBytes limits the file used by
+	// OVERFLOW_SPILL. Zero takes the limit in the sidecar-config.
+	Overflow      OverflowPolicy `protobuf:"varint,6,opt,name=overflow,proto3,enum=messages.OverflowPolicy" json:"overflow,omitempty"`
+	SpillMaxBytes uint64         `protobuf:"varint,7,opt,name=spillMaxBytes,proto3" json:"spillMaxBytes,omitempty"`
 }
 
-func (x *DocUpload) Reset() {
-	*x = DocUpload{}
+func (x *SubMsg) Reset() {
+	*x = SubMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1986,13 +2750,13 @@ func (x *DocUpload) Reset() {
 	}
 }
 
-func (x *DocUpload) String() string {
+func (x *SubMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocUpload) ProtoMessage() {}
+func (*SubMsg) ProtoMessage() {}
 
-func (x *DocUpload) ProtoReflect() protoreflect.Message {
+func (x *SubMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2004,36 +2768,74 @@ func (x *DocUpload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocUpload.ProtoReflect.Descriptor instead.
-func (*DocUpload) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubMsg.ProtoReflect.Descriptor instead.
+func (*SubMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DocUpload) GetDocuments() *Documents {
+func (x *SubMsg) GetHeader() *Header {
 	if x != nil {
-		return x.Documents
+		return x.Header
 	}
 	return nil
 }
 
-func (x *DocUpload) GetMsgNumber() uint64 {
+func (x *SubMsg) GetTopic() string {
 	if x != nil {
-		return x.MsgNumber
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SubMsg) GetChanSize() uint32 {
+	if x != nil {
+		return x.ChanSize
 	}
 	return 0
 }
 
-type DocUploadResponse struct {
+func (x *SubMsg) GetQueueGroup() string {
+	if x != nil {
+		return x.QueueGroup
+	}
+	return ""
+}
+
+func (x *SubMsg) GetSubId() uint64 {
+	if x != nil {
+		return x.SubId
+	}
+	return 0
+}
+
+func (x *SubMsg) GetOverflow() OverflowPolicy {
+	if x != nil {
+		return x.Overflow
+	}
+	return OverflowPolicy_OVERFLOW_BLOCK
+}
+
+func (x *SubMsg) GetSpillMaxBytes() uint64 {
+	if x != nil {
+		return x.SpillMaxBytes
+	}
+	return 0
+}
+
+type SubMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Control      *StreamControl `protobuf:"bytes,1,opt,name=control,proto3" json:"control,omitempty"`
-	AckMsgNumber uint64         `protobuf:"varint,2,opt,name=ackMsgNumber,proto3" json:"ackMsgNumber,omitempty"`
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Identifies this subscription in Receive and UnsubMsg.
+	SubId uint64 `protobuf:"varint,4,opt,name=subId,proto3" json:"subId,omitempty"`
 }
 
-func (x *DocUploadResponse) Reset() {
-	*x = DocUploadResponse{}
+func (x *SubMsgResponse) Reset() {
+	*x = SubMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2041,13 +2843,13 @@ func (x *DocUploadResponse) Reset() {
 	}
 }
 
-func (x *DocUploadResponse) String() string {
+func (x *SubMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocUploadResponse) ProtoMessage() {}
+func (*SubMsgResponse) ProtoMessage() {}
 
-func (x *DocUploadResponse) ProtoReflect() protoreflect.Message {
+func (x *SubMsgResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2059,37 +2861,52 @@ func (x *DocUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocUploadResponse.ProtoReflect.Descriptor instead.
-func (*DocUploadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubMsgResponse.ProtoReflect.Descriptor instead.
+func (*SubMsgResponse) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DocUploadResponse) GetControl() *StreamControl {
+func (x *SubMsgResponse) GetHeader() *Header {
 	if x != nil {
-		return x.Control
+		return x.Header
 	}
 	return nil
 }
 
-func (x *DocUploadResponse) GetAckMsgNumber() uint64 {
+func (x *SubMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
-		return x.AckMsgNumber
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *SubMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SubMsgResponse) GetSubId() uint64 {
+	if x != nil {
+		return x.SubId
 	}
 	return 0
 }
 
-type AddJSMsg struct {
+type UnsubMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
-	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic  string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// A zero subId unsubscribes all of the client's subscriptions to topic.
+	SubId uint64 `protobuf:"varint,3,opt,name=subId,proto3" json:"subId,omitempty"`
 }
 
-func (x *AddJSMsg) Reset() {
-	*x = AddJSMsg{}
+func (x *UnsubMsg) Reset() {
+	*x = UnsubMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2097,13 +2914,13 @@ func (x *AddJSMsg) Reset() {
 	}
 }
 
-func (x *AddJSMsg) String() string {
+func (x *UnsubMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddJSMsg) ProtoMessage() {}
+func (*UnsubMsg) ProtoMessage() {}
 
-func (x *AddJSMsg) ProtoReflect() protoreflect.Message {
+func (x *UnsubMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2115,46 +2932,44 @@ func (x *AddJSMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddJSMsg.ProtoReflect.Descriptor instead.
-func (*AddJSMsg) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnsubMsg.ProtoReflect.Descriptor instead.
+func (*UnsubMsg) Descriptor() ([]byte, []int) {
 	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AddJSMsg) GetHeader() *Header {
+func (x *UnsubMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *AddJSMsg) GetTopic() string {
+func (x *UnsubMsg) GetTopic() string {
 	if x != nil {
 		return x.Topic
 	}
 	return ""
 }
 
-func (x *AddJSMsg) GetWorkQueue() string {
+func (x *UnsubMsg) GetSubId() uint64 {
 	if x != nil {
-		return x.WorkQueue
+		return x.SubId
 	}
-	return ""
+	return 0
 }
 
-type AddJSMsgResponse struct {
+type UnsubMsgResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
-	Topic     string          `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
-	WorkQueue string          `protobuf:"bytes,4,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
-	Msg       string          `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (x *AddJSMsgResponse) Reset() {
-	*x = AddJSMsgResponse{}
+func (x *UnsubMsgResponse) Reset() {
+	*x = UnsubMsgResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2162,14 +2977,2270 @@ func (x *AddJSMsgResponse) Reset() {
 	}
 }
 
-func (x *AddJSMsgResponse) String() string {
+func (x *UnsubMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubMsgResponse) ProtoMessage() {}
+
+func (x *UnsubMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubMsgResponse.ProtoReflect.Descriptor instead.
+func (*UnsubMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnsubMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *UnsubMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *UnsubMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type UnsubJSMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+}
+
+func (x *UnsubJSMsg) Reset() {
+	*x = UnsubJSMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubJSMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubJSMsg) ProtoMessage() {}
+
+func (x *UnsubJSMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubJSMsg.ProtoReflect.Descriptor instead.
+func (*UnsubJSMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UnsubJSMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *UnsubJSMsg) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *UnsubJSMsg) GetWorkQueue() string {
+	if x != nil {
+		return x.WorkQueue
+	}
+	return ""
+}
+
+type UnsubJSMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *UnsubJSMsgResponse) Reset() {
+	*x = UnsubJSMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubJSMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubJSMsgResponse) ProtoMessage() {}
+
+func (x *UnsubJSMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubJSMsgResponse.ProtoReflect.Descriptor instead.
+func (*UnsubJSMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UnsubJSMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *UnsubJSMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *UnsubJSMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type Receive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic  string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	// A zero subId receives from the client's oldest subscription to topic.
+	SubId uint64 `protobuf:"varint,3,opt,name=subId,proto3" json:"subId,omitempty"`
+}
+
+func (x *Receive) Reset() {
+	*x = Receive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Receive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receive) ProtoMessage() {}
+
+func (x *Receive) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receive.ProtoReflect.Descriptor instead.
+func (*Receive) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Receive) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Receive) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Receive) GetSubId() uint64 {
+	if x != nil {
+		return x.SubId
+	}
+	return 0
+}
+
+type ReceiveJS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+}
+
+func (x *ReceiveJS) Reset() {
+	*x = ReceiveJS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceiveJS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveJS) ProtoMessage() {}
+
+func (x *ReceiveJS) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveJS.ProtoReflect.Descriptor instead.
+func (*ReceiveJS) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReceiveJS) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ReceiveJS) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ReceiveJS) GetWorkQueue() string {
+	if x != nil {
+		return x.WorkQueue
+	}
+	return ""
+}
+
+// Change this to SubTopicMsg. This is the message coming from NATS server
+// for the specific topic to which this sidecar has subscribed.
+type SubTopicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic  string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Msg    []byte  `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Set if the message is a request. Answer it by sending a RespondMsg
+	// with this reply token. The token stands for the requester's reply
+	// subject, and only this client can use it.
+	Reply string `protobuf:"bytes,4,opt,name=reply,proto3" json:"reply,omitempty"`
+	SubId uint64 `protobuf:"varint,5,opt,name=subId,proto3" json:"subId,omitempty"`
+	// Number of messages dropped on this subscription so far,
+	// because the subscriber did not keep up.
+	Dropped uint64 `protobuf:"varint,6,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	// The NATS message headers.
+	Headers map[string]*HeaderValues `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SubTopicResponse) Reset() {
+	*x = SubTopicResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubTopicResponse) ProtoMessage() {}
+
+func (x *SubTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubTopicResponse.ProtoReflect.Descriptor instead.
+func (*SubTopicResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SubTopicResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *SubTopicResponse) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SubTopicResponse) GetMsg() []byte {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *SubTopicResponse) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+func (x *SubTopicResponse) GetSubId() uint64 {
+	if x != nil {
+		return x.SubId
+	}
+	return 0
+}
+
+func (x *SubTopicResponse) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *SubTopicResponse) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Answer a request received through the sidecar.
+type RespondMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// The reply token of the request, from its SubTopicResponse.
+	Reply string `protobuf:"bytes,2,opt,name=reply,proto3" json:"reply,omitempty"`
+	Msg   []byte `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *RespondMsg) Reset() {
+	*x = RespondMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondMsg) ProtoMessage() {}
+
+func (x *RespondMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondMsg.ProtoReflect.Descriptor instead.
+func (*RespondMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RespondMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *RespondMsg) GetReply() string {
+	if x != nil {
+		return x.Reply
+	}
+	return ""
+}
+
+func (x *RespondMsg) GetMsg() []byte {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+type RespondMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *RespondMsgResponse) Reset() {
+	*x = RespondMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RespondMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondMsgResponse) ProtoMessage() {}
+
+func (x *RespondMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondMsgResponse.ProtoReflect.Descriptor instead.
+func (*RespondMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RespondMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *RespondMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *RespondMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type SubJSTopicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	Msg       []byte  `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// The NATS message headers.
+	Headers map[string]*HeaderValues `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SubJSTopicResponse) Reset() {
+	*x = SubJSTopicResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubJSTopicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubJSTopicResponse) ProtoMessage() {}
+
+func (x *SubJSTopicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubJSTopicResponse.ProtoReflect.Descriptor instead.
+func (*SubJSTopicResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SubJSTopicResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *SubJSTopicResponse) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SubJSTopicResponse) GetWorkQueue() string {
+	if x != nil {
+		return x.WorkQueue
+	}
+	return ""
+}
+
+func (x *SubJSTopicResponse) GetMsg() []byte {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+func (x *SubJSTopicResponse) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type LogMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Msg    string  `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *LogMsg) Reset() {
+	*x = LogMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMsg) ProtoMessage() {}
+
+func (x *LogMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMsg.ProtoReflect.Descriptor instead.
+func (*LogMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LogMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LogMsg) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type LogMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *LogMsgResponse) Reset() {
+	*x = LogMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMsgResponse) ProtoMessage() {}
+
+func (x *LogMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMsgResponse.ProtoReflect.Descriptor instead.
+func (*LogMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *LogMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LogMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *LogMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type Doc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DocId      uint64   `protobuf:"varint,1,opt,name=docId,proto3" json:"docId,omitempty"`
+	WordInts   []uint64 `protobuf:"varint,2,rep,packed,name=wordInts,proto3" json:"wordInts,omitempty"`
+	InputDocId string   `protobuf:"bytes,3,opt,name=inputDocId,proto3" json:"inputDocId,omitempty"`
+	UserId     string   `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId,omitempty"`
+	BusinessId string   `protobuf:"bytes,5,opt,name=businessId,proto3" json:"businessId,omitempty"`
+	Stars      float32  `protobuf:"fixed32,6,opt,name=stars,proto3" json:"stars,omitempty"`
+	Useful     uint32   `protobuf:"varint,7,opt,name=useful,proto3" json:"useful,omitempty"`
+	Funny      uint32   `protobuf:"varint,8,opt,name=funny,proto3" json:"funny,omitempty"`
+	Cool       uint32   `protobuf:"varint,9,opt,name=cool,proto3" json:"cool,omitempty"`
+	Text       string   `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
+	Date       string   `protobuf:"bytes,11,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *Doc) Reset() {
+	*x = Doc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Doc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Doc) ProtoMessage() {}
+
+func (x *Doc) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Doc.ProtoReflect.Descriptor instead.
+func (*Doc) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Doc) GetDocId() uint64 {
+	if x != nil {
+		return x.DocId
+	}
+	return 0
+}
+
+func (x *Doc) GetWordInts() []uint64 {
+	if x != nil {
+		return x.WordInts
+	}
+	return nil
+}
+
+func (x *Doc) GetInputDocId() string {
+	if x != nil {
+		return x.InputDocId
+	}
+	return ""
+}
+
+func (x *Doc) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Doc) GetBusinessId() string {
+	if x != nil {
+		return x.BusinessId
+	}
+	return ""
+}
+
+func (x *Doc) GetStars() float32 {
+	if x != nil {
+		return x.Stars
+	}
+	return 0
+}
+
+func (x *Doc) GetUseful() uint32 {
+	if x != nil {
+		return x.Useful
+	}
+	return 0
+}
+
+func (x *Doc) GetFunny() uint32 {
+	if x != nil {
+		return x.Funny
+	}
+	return 0
+}
+
+func (x *Doc) GetCool() uint32 {
+	if x != nil {
+		return x.Cool
+	}
+	return 0
+}
+
+func (x *Doc) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Doc) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type Documents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Doc []*Doc `protobuf:"bytes,1,rep,name=doc,proto3" json:"doc,omitempty"`
+}
+
+func (x *Documents) Reset() {
+	*x = Documents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Documents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Documents) ProtoMessage() {}
+
+func (x *Documents) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Documents.ProtoReflect.Descriptor instead.
+func (*Documents) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Documents) GetDoc() []*Doc {
+	if x != nil {
+		return x.Doc
+	}
+	return nil
+}
+
+type StreamControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flow StreamFlow `protobuf:"varint,1,opt,name=flow,proto3,enum=messages.StreamFlow" json:"flow,omitempty"`
+}
+
+func (x *StreamControl) Reset() {
+	*x = StreamControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamControl) ProtoMessage() {}
+
+func (x *StreamControl) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamControl.ProtoReflect.Descriptor instead.
+func (*StreamControl) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StreamControl) GetFlow() StreamFlow {
+	if x != nil {
+		return x.Flow
+	}
+	return StreamFlow_OFF
+}
+
+// Opens a document stream. It goes in the first message the client sends
+// on DocUploadStream or DocDownloadStream, and holds for the whole stream,
+// so any number of streams can run side by side.
+type DocStreamOpen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// Defaults to the subject in the sidecar-config.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Defaults to the stream in the sidecar-config.
+	Stream string `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The durable consumer to fetch from, or for uploads, the consumer
+	// whose backlog throttles the upload. Defaults to the consumer in the
+	// sidecar-config. A download creates the consumer if it does not exist.
+	DurableName string `protobuf:"bytes,4,opt,name=durableName,proto3" json:"durableName,omitempty"`
+}
+
+func (x *DocStreamOpen) Reset() {
+	*x = DocStreamOpen{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocStreamOpen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocStreamOpen) ProtoMessage() {}
+
+func (x *DocStreamOpen) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocStreamOpen.ProtoReflect.Descriptor instead.
+func (*DocStreamOpen) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DocStreamOpen) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DocStreamOpen) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *DocStreamOpen) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *DocStreamOpen) GetDurableName() string {
+	if x != nil {
+		return x.DurableName
+	}
+	return ""
+}
+
+type DocDownload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Documents *Documents `protobuf:"bytes,1,opt,name=documents,proto3" json:"documents,omitempty"`
+	MsgNumber uint64     `protobuf:"varint,2,opt,name=msgNumber,proto3" json:"msgNumber,omitempty"`
+	// Pass this to Ack once the documents are processed, or to Nak,
+	// InProgress or Term. Documents that are not acknowledged are
+	// delivered again once the consumer's AckWait runs out.
+	AckToken string `protobuf:"bytes,3,opt,name=ackToken,proto3" json:"ackToken,omitempty"`
+	// The NATS message headers.
+	Headers map[string]*HeaderValues `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DocDownload) Reset() {
+	*x = DocDownload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocDownload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocDownload) ProtoMessage() {}
+
+func (x *DocDownload) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocDownload.ProtoReflect.Descriptor instead.
+func (*DocDownload) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DocDownload) GetDocuments() *Documents {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *DocDownload) GetMsgNumber() uint64 {
+	if x != nil {
+		return x.MsgNumber
+	}
+	return 0
+}
+
+func (x *DocDownload) GetAckToken() string {
+	if x != nil {
+		return x.AckToken
+	}
+	return ""
+}
+
+func (x *DocDownload) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// Settles a JetStream message delivered through the sidecar.
+type AckMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header   *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	AckToken string  `protobuf:"bytes,2,opt,name=ackToken,proto3" json:"ackToken,omitempty"`
+	// Nak only. The message is delivered again after nakDelay.
+	// Zero delivers it again right away.
+	NakDelay *durationpb.Duration `protobuf:"bytes,3,opt,name=nakDelay,proto3" json:"nakDelay,omitempty"`
+}
+
+func (x *AckMsg) Reset() {
+	*x = AckMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckMsg) ProtoMessage() {}
+
+func (x *AckMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckMsg.ProtoReflect.Descriptor instead.
+func (*AckMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AckMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AckMsg) GetAckToken() string {
+	if x != nil {
+		return x.AckToken
+	}
+	return ""
+}
+
+func (x *AckMsg) GetNakDelay() *durationpb.Duration {
+	if x != nil {
+		return x.NakDelay
+	}
+	return nil
+}
+
+type AckMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *AckMsgResponse) Reset() {
+	*x = AckMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckMsgResponse) ProtoMessage() {}
+
+func (x *AckMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckMsgResponse.ProtoReflect.Descriptor instead.
+func (*AckMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AckMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AckMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *AckMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+type DocDownloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Control      *StreamControl `protobuf:"bytes,1,opt,name=control,proto3" json:"control,omitempty"`
+	AckMsgNumber uint64         `protobuf:"varint,2,opt,name=ackMsgNumber,proto3" json:"ackMsgNumber,omitempty"`
+	// Required in the first message, ignored after that.
+	Open *DocStreamOpen `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+}
+
+func (x *DocDownloadResponse) Reset() {
+	*x = DocDownloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocDownloadResponse) ProtoMessage() {}
+
+func (x *DocDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocDownloadResponse.ProtoReflect.Descriptor instead.
+func (*DocDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DocDownloadResponse) GetControl() *StreamControl {
+	if x != nil {
+		return x.Control
+	}
+	return nil
+}
+
+func (x *DocDownloadResponse) GetAckMsgNumber() uint64 {
+	if x != nil {
+		return x.AckMsgNumber
+	}
+	return 0
+}
+
+func (x *DocDownloadResponse) GetOpen() *DocStreamOpen {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+type DocUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Documents *Documents `protobuf:"bytes,1,opt,name=documents,proto3" json:"documents,omitempty"`
+	MsgNumber uint64     `protobuf:"varint,2,opt,name=msgNumber,proto3" json:"msgNumber,omitempty"`
+	// Required in the first message, ignored after that.
+	Open *DocStreamOpen `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+	// Sent as the Nats-Msg-Id. Defaults to a digest of the documents,
+	// so that a retried upload does not store them twice.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+}
+
+func (x *DocUpload) Reset() {
+	*x = DocUpload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocUpload) ProtoMessage() {}
+
+func (x *DocUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocUpload.ProtoReflect.Descriptor instead.
+func (*DocUpload) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DocUpload) GetDocuments() *Documents {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *DocUpload) GetMsgNumber() uint64 {
+	if x != nil {
+		return x.MsgNumber
+	}
+	return 0
+}
+
+func (x *DocUpload) GetOpen() *DocStreamOpen {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *DocUpload) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// A response on an upload stream either controls the flow, or reports
+// what happened to the message with msgNumber ackMsgNumber.
+type DocUploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Control      *StreamControl `protobuf:"bytes,1,opt,name=control,proto3" json:"control,omitempty"`
+	AckMsgNumber uint64         `protobuf:"varint,2,opt,name=ackMsgNumber,proto3" json:"ackMsgNumber,omitempty"`
+	PubAck       *PubAck        `protobuf:"bytes,3,opt,name=pubAck,proto3" json:"pubAck,omitempty"`
+	// Set if the message may not have been stored.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DocUploadResponse) Reset() {
+	*x = DocUploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DocUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocUploadResponse) ProtoMessage() {}
+
+func (x *DocUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocUploadResponse.ProtoReflect.Descriptor instead.
+func (*DocUploadResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DocUploadResponse) GetControl() *StreamControl {
+	if x != nil {
+		return x.Control
+	}
+	return nil
+}
+
+func (x *DocUploadResponse) GetAckMsgNumber() uint64 {
+	if x != nil {
+		return x.AckMsgNumber
+	}
+	return 0
+}
+
+func (x *DocUploadResponse) GetPubAck() *PubAck {
+	if x != nil {
+		return x.PubAck
+	}
+	return nil
+}
+
+func (x *DocUploadResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type HeaderValues struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *HeaderValues) Reset() {
+	*x = HeaderValues{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeaderValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeaderValues) ProtoMessage() {}
+
+func (x *HeaderValues) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeaderValues.ProtoReflect.Descriptor instead.
+func (*HeaderValues) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *HeaderValues) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// An arbitrary payload, for pipelines without a message of their own in
+// the sidecar. It goes to NATS as is: data as the message data, contentType
+// as its Content-Type header, and headers as the rest of its headers.
+type Payload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []byte                   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType string                   `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Headers     map[string]*HeaderValues `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Payload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Payload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Payload) GetHeaders() map[string]*HeaderValues {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type PayloadUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required in the first message, ignored after that.
+	Open *DocStreamOpen `protobuf:"bytes,1,opt,name=open,proto3" json:"open,omitempty"`
+	// Optional in the first message.
+	Payload   *Payload `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	MsgNumber uint64   `protobuf:"varint,3,opt,name=msgNumber,proto3" json:"msgNumber,omitempty"`
+}
+
+func (x *PayloadUpload) Reset() {
+	*x = PayloadUpload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayloadUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadUpload) ProtoMessage() {}
+
+func (x *PayloadUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadUpload.ProtoReflect.Descriptor instead.
+func (*PayloadUpload) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PayloadUpload) GetOpen() *DocStreamOpen {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *PayloadUpload) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PayloadUpload) GetMsgNumber() uint64 {
+	if x != nil {
+		return x.MsgNumber
+	}
+	return 0
+}
+
+type PayloadDownload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload *Payload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Subject string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The sequence number of the payload in its stream.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// As in DocDownload.
+	AckToken string `protobuf:"bytes,4,opt,name=ackToken,proto3" json:"ackToken,omitempty"`
+}
+
+func (x *PayloadDownload) Reset() {
+	*x = PayloadDownload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayloadDownload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadDownload) ProtoMessage() {}
+
+func (x *PayloadDownload) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadDownload.ProtoReflect.Descriptor instead.
+func (*PayloadDownload) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PayloadDownload) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *PayloadDownload) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *PayloadDownload) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PayloadDownload) GetAckToken() string {
+	if x != nil {
+		return x.AckToken
+	}
+	return ""
+}
+
+type AddJSMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Topic     string  `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	WorkQueue string  `protobuf:"bytes,3,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	// The stream the workQueue consumer belongs to. Defaults
+	// to the stream in the sidecar-config.
+	Stream string `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+}
+
+func (x *AddJSMsg) Reset() {
+	*x = AddJSMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddJSMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddJSMsg) ProtoMessage() {}
+
+func (x *AddJSMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddJSMsg.ProtoReflect.Descriptor instead.
+func (*AddJSMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AddJSMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AddJSMsg) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AddJSMsg) GetWorkQueue() string {
+	if x != nil {
+		return x.WorkQueue
+	}
+	return ""
+}
+
+func (x *AddJSMsg) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type AddJSMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Topic     string          `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	WorkQueue string          `protobuf:"bytes,4,opt,name=workQueue,proto3" json:"workQueue,omitempty"`
+	Msg       string          `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *AddJSMsgResponse) Reset() {
+	*x = AddJSMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddJSMsgResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*AddJSMsgResponse) ProtoMessage() {}
 
-func (x *AddJSMsgResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[30]
+func (x *AddJSMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddJSMsgResponse.ProtoReflect.Descriptor instead.
+func (*AddJSMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AddJSMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *AddJSMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *AddJSMsgResponse) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AddJSMsgResponse) GetWorkQueue() string {
+	if x != nil {
+		return x.WorkQueue
+	}
+	return ""
+}
+
+func (x *AddJSMsgResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// Zero limits are unlimited.
+type StreamConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Subjects  []string             `protobuf:"bytes,2,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Retention RetentionPolicy      `protobuf:"varint,3,opt,name=retention,proto3,enum=messages.RetentionPolicy" json:"retention,omitempty"`
+	Storage   StorageType          `protobuf:"varint,4,opt,name=storage,proto3,enum=messages.StorageType" json:"storage,omitempty"`
+	MaxAge    *durationpb.Duration `protobuf:"bytes,5,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	MaxBytes  int64                `protobuf:"varint,6,opt,name=maxBytes,proto3" json:"maxBytes,omitempty"`
+	MaxMsgs   int64                `protobuf:"varint,7,opt,name=maxMsgs,proto3" json:"maxMsgs,omitempty"`
+	Replicas  int32                `protobuf:"varint,8,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// Messages published with the same message ID within this
+	// window are only stored once. Zero takes the server default.
+	DuplicateWindow *durationpb.Duration `protobuf:"bytes,9,opt,name=duplicateWindow,proto3" json:"duplicateWindow,omitempty"`
+}
+
+func (x *StreamConfig) Reset() {
+	*x = StreamConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConfig) ProtoMessage() {}
+
+func (x *StreamConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConfig.ProtoReflect.Descriptor instead.
+func (*StreamConfig) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *StreamConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamConfig) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *StreamConfig) GetRetention() RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return RetentionPolicy_RETENTION_LIMITS
+}
+
+func (x *StreamConfig) GetStorage() StorageType {
+	if x != nil {
+		return x.Storage
+	}
+	return StorageType_STORAGE_FILE
+}
+
+func (x *StreamConfig) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *StreamConfig) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *StreamConfig) GetMaxMsgs() int64 {
+	if x != nil {
+		return x.MaxMsgs
+	}
+	return 0
+}
+
+func (x *StreamConfig) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *StreamConfig) GetDuplicateWindow() *durationpb.Duration {
+	if x != nil {
+		return x.DuplicateWindow
+	}
+	return nil
+}
+
+type StreamInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config    *StreamConfig          `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Created   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	Msgs      uint64                 `protobuf:"varint,3,opt,name=msgs,proto3" json:"msgs,omitempty"`
+	Bytes     uint64                 `protobuf:"varint,4,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	FirstSeq  uint64                 `protobuf:"varint,5,opt,name=firstSeq,proto3" json:"firstSeq,omitempty"`
+	LastSeq   uint64                 `protobuf:"varint,6,opt,name=lastSeq,proto3" json:"lastSeq,omitempty"`
+	Consumers uint32                 `protobuf:"varint,7,opt,name=consumers,proto3" json:"consumers,omitempty"`
+}
+
+func (x *StreamInfo) Reset() {
+	*x = StreamInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInfo) ProtoMessage() {}
+
+func (x *StreamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInfo.ProtoReflect.Descriptor instead.
+func (*StreamInfo) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *StreamInfo) GetConfig() *StreamConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *StreamInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *StreamInfo) GetMsgs() uint64 {
+	if x != nil {
+		return x.Msgs
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetBytes() uint64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetFirstSeq() uint64 {
+	if x != nil {
+		return x.FirstSeq
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetLastSeq() uint64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetConsumers() uint32 {
+	if x != nil {
+		return x.Consumers
+	}
+	return 0
+}
+
+// Zero values take the server defaults.
+type ConsumerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DurableName   string               `protobuf:"bytes,1,opt,name=durableName,proto3" json:"durableName,omitempty"`
+	FilterSubject string               `protobuf:"bytes,2,opt,name=filterSubject,proto3" json:"filterSubject,omitempty"`
+	AckPolicy     AckPolicy            `protobuf:"varint,3,opt,name=ackPolicy,proto3,enum=messages.AckPolicy" json:"ackPolicy,omitempty"`
+	AckWait       *durationpb.Duration `protobuf:"bytes,4,opt,name=ackWait,proto3" json:"ackWait,omitempty"`
+	MaxDeliver    int32                `protobuf:"varint,5,opt,name=maxDeliver,proto3" json:"maxDeliver,omitempty"`
+	MaxAckPending int32                `protobuf:"varint,6,opt,name=maxAckPending,proto3" json:"maxAckPending,omitempty"`
+}
+
+func (x *ConsumerConfig) Reset() {
+	*x = ConsumerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerConfig) ProtoMessage() {}
+
+func (x *ConsumerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerConfig.ProtoReflect.Descriptor instead.
+func (*ConsumerConfig) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ConsumerConfig) GetDurableName() string {
+	if x != nil {
+		return x.DurableName
+	}
+	return ""
+}
+
+func (x *ConsumerConfig) GetFilterSubject() string {
+	if x != nil {
+		return x.FilterSubject
+	}
+	return ""
+}
+
+func (x *ConsumerConfig) GetAckPolicy() AckPolicy {
+	if x != nil {
+		return x.AckPolicy
+	}
+	return AckPolicy_ACK_POLICY_EXPLICIT
+}
+
+func (x *ConsumerConfig) GetAckWait() *durationpb.Duration {
+	if x != nil {
+		return x.AckWait
+	}
+	return nil
+}
+
+func (x *ConsumerConfig) GetMaxDeliver() int32 {
+	if x != nil {
+		return x.MaxDeliver
+	}
+	return 0
+}
+
+func (x *ConsumerConfig) GetMaxAckPending() int32 {
+	if x != nil {
+		return x.MaxAckPending
+	}
+	return 0
+}
+
+type ConsumerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stream         string                 `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	Config         *ConsumerConfig        `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Created        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	NumPending     uint64                 `protobuf:"varint,4,opt,name=numPending,proto3" json:"numPending,omitempty"`
+	NumAckPending  uint64                 `protobuf:"varint,5,opt,name=numAckPending,proto3" json:"numAckPending,omitempty"`
+	NumRedelivered uint64                 `protobuf:"varint,6,opt,name=numRedelivered,proto3" json:"numRedelivered,omitempty"`
+	NumWaiting     uint64                 `protobuf:"varint,7,opt,name=numWaiting,proto3" json:"numWaiting,omitempty"`
+}
+
+func (x *ConsumerInfo) Reset() {
+	*x = ConsumerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerInfo) ProtoMessage() {}
+
+func (x *ConsumerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerInfo.ProtoReflect.Descriptor instead.
+func (*ConsumerInfo) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ConsumerInfo) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ConsumerInfo) GetConfig() *ConsumerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ConsumerInfo) GetCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ConsumerInfo) GetNumPending() uint64 {
+	if x != nil {
+		return x.NumPending
+	}
+	return 0
+}
+
+func (x *ConsumerInfo) GetNumAckPending() uint64 {
+	if x != nil {
+		return x.NumAckPending
+	}
+	return 0
+}
+
+func (x *ConsumerInfo) GetNumRedelivered() uint64 {
+	if x != nil {
+		return x.NumRedelivered
+	}
+	return 0
+}
+
+func (x *ConsumerInfo) GetNumWaiting() uint64 {
+	if x != nil {
+		return x.NumWaiting
+	}
+	return 0
+}
+
+// Creates or updates a stream.
+type StreamMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Config *StreamConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *StreamMsg) Reset() {
+	*x = StreamMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMsg) ProtoMessage() {}
+
+func (x *StreamMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,46 +5251,353 @@ func (x *AddJSMsgResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddJSMsgResponse.ProtoReflect.Descriptor instead.
-func (*AddJSMsgResponse) Descriptor() ([]byte, []int) {
-	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use StreamMsg.ProtoReflect.Descriptor instead.
+func (*StreamMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AddJSMsgResponse) GetHeader() *Header {
+func (x *StreamMsg) GetHeader() *Header {
 	if x != nil {
 		return x.Header
 	}
 	return nil
 }
 
-func (x *AddJSMsgResponse) GetRspHeader() *ResponseHeader {
+func (x *StreamMsg) GetConfig() *StreamConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// Deletes or inspects a stream.
+type StreamNameMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Name   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StreamNameMsg) Reset() {
+	*x = StreamNameMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamNameMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamNameMsg) ProtoMessage() {}
+
+func (x *StreamNameMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamNameMsg.ProtoReflect.Descriptor instead.
+func (*StreamNameMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *StreamNameMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StreamNameMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StreamMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Not set when the stream was deleted.
+	Info *StreamInfo `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *StreamMsgResponse) Reset() {
+	*x = StreamMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMsgResponse) ProtoMessage() {}
+
+func (x *StreamMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMsgResponse.ProtoReflect.Descriptor instead.
+func (*StreamMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StreamMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *StreamMsgResponse) GetRspHeader() *ResponseHeader {
 	if x != nil {
 		return x.RspHeader
 	}
 	return nil
 }
 
-func (x *AddJSMsgResponse) GetTopic() string {
+func (x *StreamMsgResponse) GetMsg() string {
 	if x != nil {
-		return x.Topic
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *AddJSMsgResponse) GetWorkQueue() string {
+func (x *StreamMsgResponse) GetInfo() *StreamInfo {
 	if x != nil {
-		return x.WorkQueue
+		return x.Info
+	}
+	return nil
+}
+
+// Creates or updates a durable consumer on a stream.
+type ConsumerMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Stream string          `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	Config *ConsumerConfig `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConsumerMsg) Reset() {
+	*x = ConsumerMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerMsg) ProtoMessage() {}
+
+func (x *ConsumerMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerMsg.ProtoReflect.Descriptor instead.
+func (*ConsumerMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ConsumerMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ConsumerMsg) GetStream() string {
+	if x != nil {
+		return x.Stream
 	}
 	return ""
 }
 
-func (x *AddJSMsgResponse) GetMsg() string {
+func (x *ConsumerMsg) GetConfig() *ConsumerConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// Deletes or inspects a durable consumer.
+type ConsumerNameMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header      *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Stream      string  `protobuf:"bytes,2,opt,name=stream,proto3" json:"stream,omitempty"`
+	DurableName string  `protobuf:"bytes,3,opt,name=durableName,proto3" json:"durableName,omitempty"`
+}
+
+func (x *ConsumerNameMsg) Reset() {
+	*x = ConsumerNameMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerNameMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerNameMsg) ProtoMessage() {}
+
+func (x *ConsumerNameMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerNameMsg.ProtoReflect.Descriptor instead.
+func (*ConsumerNameMsg) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ConsumerNameMsg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ConsumerNameMsg) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *ConsumerNameMsg) GetDurableName() string {
+	if x != nil {
+		return x.DurableName
+	}
+	return ""
+}
+
+type ConsumerMsgResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	RspHeader *ResponseHeader `protobuf:"bytes,2,opt,name=rspHeader,proto3" json:"rspHeader,omitempty"`
+	Msg       string          `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Not set when the consumer was deleted.
+	Info *ConsumerInfo `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *ConsumerMsgResponse) Reset() {
+	*x = ConsumerMsgResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_v1_messages_sidecar_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsumerMsgResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumerMsgResponse) ProtoMessage() {}
+
+func (x *ConsumerMsgResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_v1_messages_sidecar_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumerMsgResponse.ProtoReflect.Descriptor instead.
+func (*ConsumerMsgResponse) Descriptor() ([]byte, []int) {
+	return file_protos_v1_messages_sidecar_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ConsumerMsgResponse) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *ConsumerMsgResponse) GetRspHeader() *ResponseHeader {
+	if x != nil {
+		return x.RspHeader
+	}
+	return nil
+}
+
+func (x *ConsumerMsgResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
+func (x *ConsumerMsgResponse) GetInfo() *ConsumerInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
 var File_protos_v1_messages_sidecar_proto protoreflect.FileDescriptor
 
 var file_protos_v1_messages_sidecar_proto_rawDesc = []byte{
@@ -2229,119 +5607,172 @@ var file_protos_v1_messages_sidecar_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x01, 0x0a, 0x06, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x53, 0x65, 0x72, 0x76, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x72, 0x63, 0x53, 0x65, 0x72, 0x76, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x49, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6d, 0x73,
-	0x67, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x66, 0x0a,
-	0x0d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65,
-	0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x01, 0x0a, 0x06, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x53, 0x65, 0x72, 0x76, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x72, 0x63, 0x53, 0x65, 0x72, 0x76,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x49, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6d,
+	0x73, 0x67, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xcc,
+	0x02, 0x0a, 0x0d, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4e, 0x75, 0x6d, 0x12, 0x39, 0x0a, 0x0a,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79,
-	0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0xbe, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x17,
-	0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68,
-	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x63,
-	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e,
-	0x63, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52,
-	0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x72, 0x65, 0x67, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x09, 0x72, 0x65, 0x67, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
-	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x73, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x65,
-	0x72, 0x76, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x06, 0x50,
-	0x75, 0x62, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52,
-	0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xa9, 0x01,
-	0x0a, 0x08, 0x50, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f,
-	0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77,
-	0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x05, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69,
-	0x6f, 0x72, 0x52, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x22, 0xa4, 0x01, 0x0a, 0x10, 0x50, 0x75,
-	0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x4f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x22, 0x93, 0x03,
+	0x0a, 0x14, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x70,
+	0x65, 0x6e, 0x4d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x68, 0x61, 0x6c, 0x66, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x78, 0x50, 0x72,
+	0x6f, 0x62, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x3b, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x41, 0x0a,
+	0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x47, 0x0a, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x65, 0x62, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x2f, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x57, 0x61, 0x69, 0x74, 0x22, 0x9b, 0x05, 0x0a,
+	0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x3f, 0x0a,
+	0x0d, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2d,
+	0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x35, 0x0a,
+	0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x54, 0x54, 0x4c, 0x12, 0x46, 0x0a, 0x0e, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0e, 0x63, 0x69,
+	0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c,
+	0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x64, 0x65, 0x62, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x55, 0x0a, 0x0d, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x54, 0x6f,
+	0x70, 0x69, 0x63, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0d, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12,
+	0x31, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x0e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a,
+	0x59, 0x0a, 0x12, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x28,
 	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
 	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x22, 0x64, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68,
-	0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x4d, 0x73,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d,
-	0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x4a, 0x0a,
-	0x08, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x55, 0x6e,
-	0x73, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x72, 0x65,
+	0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x09, 0x72, 0x65, 0x67,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09,
+	0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x11, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x12,
+	0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x8f, 0x01, 0x0a, 0x19, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09,
+	0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x38, 0x0a, 0x0c, 0x4b,
+	0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xad, 0x02, 0x0a, 0x14, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c,
+	0x69, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
 	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
 	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
 	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48,
@@ -2349,53 +5780,219 @@ var file_protos_v1_messages_sidecar_proto_rawDesc = []byte{
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
 	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
 	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x73, 0x67, 0x22, 0x6a, 0x0a, 0x0a, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67,
-	0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x88,
-	0x01, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73,
-	0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x49, 0x0a, 0x07, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x73, 0x67, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54, 0x54, 0x4c, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x46, 0x0a,
+	0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x83, 0x02, 0x0a, 0x0f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72,
+	0x76, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x3e, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x13,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x0e,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x59, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12,
+	0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x13,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a,
+	0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x22, 0xbe, 0x02, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a,
+	0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x50, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x52, 0x0a,
+	0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xde, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x70, 0x69, 0x63, 0x22, 0x69, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4a,
-	0x53, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x62, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x62,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x73,
+	0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61,
 	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
 	0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
-	0x63, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22,
-	0x64, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x05, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x95, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x22,
+	0x5a, 0x0a, 0x06, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0x90, 0x03, 0x0a, 0x08,
+	0x50, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72,
+	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x05, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x52, 0x05, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x63, 0x6b, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07,
+	0x61, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x39, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x4a,
+	0x53, 0x4d, 0x73, 0x67, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x52, 0x0a, 0x0c, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe4,
+	0x01, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a,
+	0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x52, 0x06, 0x70, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x75, 0x62, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x70, 0x75, 0x62, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x73,
+	0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xa8, 0x01,
+	0x0a, 0x0c, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x75, 0x62, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x75, 0x62, 0x49, 0x64, 0x12, 0x28,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x06, 0x53, 0x75, 0x62,
+	0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48,
 	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a,
 	0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x84, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x4a, 0x53, 0x54,
-	0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x44, 0x0a, 0x06,
-	0x4c, 0x6f, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d,
-	0x73, 0x67, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f,
+	0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x24, 0x0a, 0x0d, 0x73,
+	0x70, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x73, 0x70, 0x69, 0x6c, 0x6c, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x62, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x22, 0x60,
+	0x0a, 0x08, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75,
+	0x62, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64,
+	0x22, 0x86, 0x01, 0x0a, 0x10, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
 	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
@@ -2403,63 +6000,241 @@ var file_protos_v1_messages_sidecar_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73,
 	0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x8f, 0x02, 0x0a, 0x03, 0x44, 0x6f,
-	0x63, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x64, 0x49,
-	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x64, 0x49,
-	0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x6f, 0x63, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x6f,
-	0x63, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
-	0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x6e,
-	0x6e, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x6e, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6f, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63,
-	0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2c, 0x0a, 0x09, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x44, 0x6f, 0x63, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x22, 0x39, 0x0a, 0x0d, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x66, 0x6c,
-	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x04,
-	0x66, 0x6c, 0x6f, 0x77, 0x22, 0x5e, 0x0a, 0x0b, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x6a, 0x0a, 0x0a, 0x55, 0x6e, 0x73,
+	0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4a,
+	0x53, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x22, 0x5f, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x75, 0x62, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49,
+	0x64, 0x22, 0x69, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4a, 0x53, 0x12, 0x28,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0xc1, 0x02, 0x0a,
+	0x10, 0x53, 0x75, 0x62, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x75, 0x62,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x52, 0x0a, 0x0c,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x5e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x28,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x22, 0x88, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09,
+	0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x9d, 0x02, 0x0a, 0x12,
+	0x53, 0x75, 0x62, 0x4a, 0x53, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d,
+	0x73, 0x67, 0x12, 0x43, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53,
+	0x75, 0x62, 0x4a, 0x53, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x52, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x44, 0x0a, 0x06, 0x4c,
+	0x6f, 0x67, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36,
+	0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x8f, 0x02, 0x0a, 0x03, 0x44, 0x6f, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x64, 0x6f, 0x63, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x6e,
+	0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x6f, 0x63, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x44, 0x6f, 0x63,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75,
+	0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x75, 0x73, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x66, 0x75, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x6e, 0x6e,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x75, 0x6e, 0x6e, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x6f, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f,
+	0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x2c, 0x0a, 0x09, 0x44, 0x6f,
+	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x64, 0x6f, 0x63, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x44, 0x6f, 0x63, 0x52, 0x03, 0x64, 0x6f, 0x63, 0x22, 0x39, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x28, 0x0a, 0x04, 0x66, 0x6c, 0x6f,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x04, 0x66,
+	0x6c, 0x6f, 0x77, 0x22, 0x8d, 0x01, 0x0a, 0x0d, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x8c, 0x02, 0x0a, 0x0b, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
 	0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x64, 0x6f, 0x63,
 	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d,
 	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0x6c, 0x0a, 0x13, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x22,
-	0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x22, 0x5c, 0x0a, 0x09, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x31, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f,
-	0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0x6a, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x3c, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x52,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x85, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x6e, 0x61, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x6e, 0x61, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x41,
+	0x63, 0x6b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x22, 0x99, 0x01, 0x0a, 0x13, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x61, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x2b, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x22, 0xb1, 0x01,
+	0x0a, 0x09, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x04,
+	0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f,
+	0x70, 0x65, 0x6e, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63,
+	0x6b, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x61, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x26,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x1a, 0x52, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x63, 0x6b, 0x4d,
-	0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x61, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x08,
-	0x41, 0x64, 0x64, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b,
-	0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72,
-	0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4a, 0x53,
+	0x73, 0x2e, 0x44, 0x6f, 0x63, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x65, 0x6e, 0x52,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x90, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x6b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x4a, 0x53, 0x4d, 0x73, 0x67,
+	0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4a, 0x53,
 	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x68,
 	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65,
 	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
@@ -2471,92 +6246,366 @@ var file_protos_v1_messages_sidecar_proto_rawDesc = []byte{
 	0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x51, 0x75, 0x65, 0x75,
 	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6d, 0x73, 0x67, 0x2a, 0xb3, 0x03, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x47, 0x10,
-	0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
-	0x47, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x03, 0x12,
-	0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x10,
-	0x04, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55,
-	0x42, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x53, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13,
-	0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x5f,
-	0x52, 0x53, 0x50, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x53, 0x55, 0x42, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x09, 0x12, 0x13, 0x0a,
-	0x0f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x4a, 0x53,
-	0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
-	0x55, 0x42, 0x5f, 0x4a, 0x53, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x0b, 0x12, 0x1a, 0x0a, 0x16, 0x4d,
-	0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x54, 0x4f, 0x50, 0x49,
-	0x43, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x0c, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x53, 0x47, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43,
-	0x5f, 0x52, 0x53, 0x50, 0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x42, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x53,
-	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x42, 0x5f, 0x52, 0x53, 0x50,
-	0x10, 0x0f, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x53, 0x47,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x5f, 0x52,
-	0x53, 0x50, 0x10, 0x11, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x41, 0x44, 0x44, 0x5f, 0x4a, 0x53, 0x10, 0x12, 0x2a, 0x76, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x45,
-	0x52, 0x52, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x49, 0x4e,
-	0x47, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x52, 0x52, 0x5f, 0x53,
-	0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e,
-	0x45, 0x52, 0x52, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x04,
-	0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x52, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x10,
-	0x05, 0x2a, 0x4c, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x12,
+	0x6d, 0x73, 0x67, 0x22, 0xf2, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x31,
+	0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xf0, 0x01, 0x0a, 0x0a, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6d, 0x73, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x53, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x53, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x09, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09,
+	0x61, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x6b,
+	0x57, 0x61, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x6b, 0x57, 0x61, 0x69, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x41, 0x63, 0x6b, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x22, 0x9c, 0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x30, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x41, 0x63, 0x6b, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x41, 0x63, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x6e,
+	0x75, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x57, 0x61, 0x69, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x57, 0x61, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x22, 0x65, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x73, 0x67,
+	0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4d, 0x0a, 0x0d, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73, 0x70,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x81, 0x01,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x75, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x4d, 0x73, 0x67, 0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x75, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x72, 0x73,
+	0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x09, 0x72, 0x73, 0x70, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6d, 0x73, 0x67, 0x12, 0x2a, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x2a, 0xd9, 0x06, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c,
+	0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x47, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x47, 0x5f, 0x52,
+	0x53, 0x50, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c,
+	0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x10, 0x04, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x5f, 0x52,
+	0x53, 0x50, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x50, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x53, 0x47,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x5f, 0x52, 0x53, 0x50,
+	0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x55, 0x42, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x55, 0x42, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x53,
+	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x10, 0x0a, 0x12,
+	0x17, 0x0a, 0x13, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f,
+	0x4a, 0x53, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x0b, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x53, 0x47, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x52,
+	0x53, 0x50, 0x10, 0x0c, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x5f, 0x52, 0x53,
+	0x50, 0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x55, 0x42, 0x10, 0x0e, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x53, 0x47, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x42, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x0f, 0x12,
+	0x15, 0x0a, 0x11, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x55,
+	0x42, 0x5f, 0x4a, 0x53, 0x10, 0x10, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x55, 0x42, 0x5f, 0x4a, 0x53, 0x5f, 0x52, 0x53, 0x50, 0x10,
+	0x11, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x44,
+	0x44, 0x5f, 0x4a, 0x53, 0x10, 0x12, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x44, 0x45, 0x52, 0x45, 0x47, 0x10, 0x13, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x53,
+	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x52, 0x45, 0x47, 0x5f, 0x52, 0x53, 0x50,
+	0x10, 0x14, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b,
+	0x45, 0x45, 0x50, 0x41, 0x4c, 0x49, 0x56, 0x45, 0x10, 0x15, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x53,
+	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x41, 0x4c, 0x49, 0x56, 0x45,
+	0x5f, 0x52, 0x53, 0x50, 0x10, 0x16, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x10, 0x17, 0x12, 0x19, 0x0a,
+	0x15, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56,
+	0x45, 0x52, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x18, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x10, 0x19, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53,
+	0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x1a,
+	0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x4f, 0x4e, 0x44, 0x10, 0x1b, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x1c,
+	0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x4b,
+	0x10, 0x1d, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x43, 0x4b, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x1e, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x53, 0x47, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x1f, 0x12, 0x17, 0x0a,
+	0x13, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x52, 0x53, 0x50, 0x10, 0x20, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x52, 0x10, 0x21, 0x12, 0x19, 0x0a,
+	0x15, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d,
+	0x45, 0x52, 0x5f, 0x52, 0x53, 0x50, 0x10, 0x22, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x53, 0x47, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x5f, 0x41, 0x43, 0x4b, 0x53, 0x10, 0x23, 0x12,
+	0x1b, 0x0a, 0x17, 0x4d, 0x53, 0x47, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x5f,
+	0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x24, 0x2a, 0xa4, 0x01, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x45, 0x52, 0x52, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d,
+	0x53, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x52, 0x52, 0x5f, 0x52, 0x45, 0x43, 0x45,
+	0x49, 0x56, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x45,
+	0x52, 0x52, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x42, 0x49, 0x4e, 0x47, 0x10, 0x03,
+	0x12, 0x12, 0x0a, 0x0e, 0x45, 0x52, 0x52, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x49,
+	0x4e, 0x47, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x52, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x47,
+	0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x06, 0x12, 0x14, 0x0a,
+	0x10, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x49, 0x52, 0x43, 0x55, 0x49, 0x54, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x10, 0x07, 0x2a, 0x52, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x4e,
+	0x4f, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
+	0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x47, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x45, 0x42, 0x4f, 0x55,
+	0x4e, 0x43, 0x45, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x45, 0x42, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x44, 0x45, 0x42, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x02,
+	0x2a, 0x31, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x0c, 0x50, 0x55, 0x42, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x50, 0x55, 0x42, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x41, 0x53, 0x59, 0x4e,
+	0x43, 0x10, 0x01, 0x2a, 0x6c, 0x0a, 0x0e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f,
+	0x57, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x56, 0x45,
+	0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x4f, 0x4c, 0x44, 0x45, 0x53,
+	0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x12, 0x0a,
+	0x0e, 0x4f, 0x56, 0x45, 0x52, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x53, 0x50, 0x49, 0x4c, 0x4c, 0x10,
+	0x03, 0x2a, 0x4c, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x6f, 0x77, 0x12,
 	0x07, 0x0a, 0x03, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4e, 0x10, 0x01,
 	0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x02, 0x12, 0x0c,
 	0x0a, 0x08, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
-	0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x32,
-	0xdc, 0x05, 0x0a, 0x07, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x48, 0x0a, 0x08, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
-	0x73, 0x67, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x53, 0x75, 0x62, 0x12, 0x10, 0x2e, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x1a, 0x18,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x4d, 0x73, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x1a, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30,
-	0x01, 0x12, 0x4d, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x28, 0x01, 0x30, 0x01,
-	0x12, 0x35, 0x0a, 0x04, 0x52, 0x65, 0x63, 0x76, 0x12, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x1a, 0x1a, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x76, 0x4a,
-	0x53, 0x12, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x4a, 0x53, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x53, 0x75, 0x62, 0x4a, 0x53, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x12, 0x12, 0x2e,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4d, 0x73,
-	0x67, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73,
-	0x75, 0x62, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
-	0x07, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4a, 0x53, 0x12, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x1a, 0x1c,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4a,
-	0x53, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03,
-	0x50, 0x75, 0x62, 0x12, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50,
-	0x75, 0x62, 0x4d, 0x73, 0x67, 0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x2e, 0x50, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x05, 0x50, 0x75, 0x62, 0x4a, 0x53, 0x12, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x10, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x4a, 0x53, 0x12, 0x12,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4a, 0x53, 0x4d,
-	0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34,
+	0x43, 0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x45, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x2a,
+	0x59, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x53, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x54, 0x45,
+	0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x10, 0x01,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x54, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x4f,
+	0x52, 0x4b, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x02, 0x2a, 0x33, 0x0a, 0x0b, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x4f,
+	0x52, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53,
+	0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x2a,
+	0x4d, 0x0a, 0x09, 0x41, 0x63, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x43, 0x4b, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x58, 0x50, 0x4c, 0x49,
+	0x43, 0x49, 0x54, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x4b, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x43,
+	0x4b, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x32, 0xdd,
+	0x10, 0x0a, 0x07, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x48, 0x0a, 0x08, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x67, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x1a,
+	0x23, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x09, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76,
+	0x65, 0x12, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x65,
+	0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x1d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x53,
+	0x75, 0x62, 0x12, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75,
+	0x62, 0x4d, 0x73, 0x67, 0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x53, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47,
+	0x0a, 0x0f, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x15, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x13, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x44, 0x6f, 0x63, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x15, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x44, 0x6f, 0x63, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a,
+	0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x28, 0x01, 0x30, 0x01, 0x12, 0x35,
+	0x0a, 0x04, 0x52, 0x65, 0x63, 0x76, 0x12, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x12, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75,
+	0x62, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x53, 0x75, 0x62, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x3b, 0x0a, 0x06, 0x52, 0x65, 0x63, 0x76, 0x4a, 0x53, 0x12, 0x13, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4a,
+	0x53, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62,
+	0x4a, 0x53, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x05, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x12, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x4a, 0x53, 0x12, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x50, 0x75, 0x62, 0x12, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x4d, 0x73, 0x67,
+	0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x1a, 0x1c, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x12, 0x14, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x50, 0x75, 0x62, 0x4a,
+	0x53, 0x12, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62,
+	0x4a, 0x53, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x2e, 0x50, 0x75, 0x62, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x41, 0x63, 0x6b, 0x73, 0x4d,
+	0x73, 0x67, 0x1a, 0x16, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x50, 0x75,
+	0x62, 0x41, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x30, 0x01, 0x12, 0x2f, 0x0a, 0x03,
+	0x4c, 0x6f, 0x67, 0x12, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x4c,
+	0x6f, 0x67, 0x4d, 0x73, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a,
+	0x05, 0x41, 0x64, 0x64, 0x4a, 0x53, 0x12, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4a, 0x53, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x41, 0x63, 0x6b, 0x12, 0x10, 0x2e,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x1a,
+	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x4e, 0x61, 0x6b,
+	0x12, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x4d,
+	0x73, 0x67, 0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x6b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a,
+	0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x2e, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x1a, 0x18, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x10,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x4d, 0x73, 0x67,
+	0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x41, 0x63, 0x6b, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x41, 0x64,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x73, 0x67, 0x1a, 0x1b, 0x2e, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x73,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x13, 0x2e, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x73, 0x67, 0x1a, 0x1b,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x17, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d,
+	0x65, 0x4d, 0x73, 0x67, 0x1a, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x17, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x1a, 0x1b, 0x2e, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x1d,
+	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a,
+	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12,
+	0x15, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x4d,
+	0x73, 0x67, 0x1a, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x4d, 0x73, 0x67, 0x1a,
+	0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34,
 	0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x61, 0x6d,
 	0x69, 0x72, 0x67, 0x61, 0x64, 0x6b, 0x61, 0x72, 0x69, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
 	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73,
@@ -2575,117 +6624,302 @@ func file_protos_v1_messages_sidecar_proto_rawDescGZIP() []byte {
 	return file_protos_v1_messages_sidecar_proto_rawDescData
 }
 
-var file_protos_v1_messages_sidecar_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_protos_v1_messages_sidecar_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_protos_v1_messages_sidecar_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_protos_v1_messages_sidecar_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
 var file_protos_v1_messages_sidecar_proto_goTypes = []interface{}{
-	(MsgType)(0),                    // 0: messages.MsgType
-	(Status)(0),                     // 1: messages.Status
-	(StreamFlow)(0),                 // 2: messages.StreamFlow
-	(*Header)(nil),                  // 3: messages.Header
-	(*ResponseHeader)(nil),          // 4: messages.ResponseHeader
-	(*RetryBehavior)(nil),           // 5: messages.RetryBehavior
-	(*RegistrationParams)(nil),      // 6: messages.RegistrationParams
-	(*RegistrationMsg)(nil),         // 7: messages.RegistrationMsg
-	(*RegistrationMsgResponse)(nil), // 8: messages.RegistrationMsgResponse
-	(*PubMsg)(nil),                  // 9: messages.PubMsg
-	(*PubMsgResponse)(nil),          // 10: messages.PubMsgResponse
-	(*PubJSMsg)(nil),                // 11: messages.PubJSMsg
-	(*PubJSMsgResponse)(nil),        // 12: messages.PubJSMsgResponse
-	(*SubMsg)(nil),                  // 13: messages.SubMsg
-	(*SubMsgResponse)(nil),          // 14: messages.SubMsgResponse
-	(*UnsubMsg)(nil),                // 15: messages.UnsubMsg
-	(*UnsubMsgResponse)(nil),        // 16: messages.UnsubMsgResponse
-	(*UnsubJSMsg)(nil),              // 17: messages.UnsubJSMsg
-	(*UnsubJSMsgResponse)(nil),      // 18: messages.UnsubJSMsgResponse
-	(*Receive)(nil),                 // 19: messages.Receive
-	(*ReceiveJS)(nil),               // 20: messages.ReceiveJS
-	(*SubTopicResponse)(nil),        // 21: messages.SubTopicResponse
-	(*SubJSTopicResponse)(nil),      // 22: messages.SubJSTopicResponse
-	(*LogMsg)(nil),                  // 23: messages.LogMsg
-	(*LogMsgResponse)(nil),          // 24: messages.LogMsgResponse
-	(*Doc)(nil),                     // 25: messages.Doc
-	(*Documents)(nil),               // 26: messages.Documents
-	(*StreamControl)(nil),           // 27: messages.StreamControl
-	(*DocDownload)(nil),             // 28: messages.DocDownload
-	(*DocDownloadResponse)(nil),     // 29: messages.DocDownloadResponse
-	(*DocUpload)(nil),               // 30: messages.DocUpload
-	(*DocUploadResponse)(nil),       // 31: messages.DocUploadResponse
-	(*AddJSMsg)(nil),                // 32: messages.AddJSMsg
-	(*AddJSMsgResponse)(nil),        // 33: messages.AddJSMsgResponse
-	(*durationpb.Duration)(nil),     // 34: google.protobuf.Duration
-	(*emptypb.Empty)(nil),           // 35: google.protobuf.Empty
+	(MsgType)(0),                      // 0: messages.MsgType
+	(Status)(0),                       // 1: messages.Status
+	(RetryableError)(0),               // 2: messages.RetryableError
+	(DebounceMode)(0),                 // 3: messages.DebounceMode
+	(PubAckMode)(0),                   // 4: messages.PubAckMode
+	(OverflowPolicy)(0),               // 5: messages.OverflowPolicy
+	(StreamFlow)(0),                   // 6: messages.StreamFlow
+	(RetentionPolicy)(0),              // 7: messages.RetentionPolicy
+	(StorageType)(0),                  // 8: messages.StorageType
+	(AckPolicy)(0),                    // 9: messages.AckPolicy
+	(*Header)(nil),                    // 10: messages.Header
+	(*ResponseHeader)(nil),            // 11: messages.ResponseHeader
+	(*RetryBehavior)(nil),             // 12: messages.RetryBehavior
+	(*CircuitBreakerParams)(nil),      // 13: messages.CircuitBreakerParams
+	(*TopicDebounce)(nil),             // 14: messages.TopicDebounce
+	(*RateLimit)(nil),                 // 15: messages.RateLimit
+	(*RegistrationParams)(nil),        // 16: messages.RegistrationParams
+	(*RegistrationMsg)(nil),           // 17: messages.RegistrationMsg
+	(*RegistrationMsgResponse)(nil),   // 18: messages.RegistrationMsgResponse
+	(*DeregistrationMsg)(nil),         // 19: messages.DeregistrationMsg
+	(*DeregistrationMsgResponse)(nil), // 20: messages.DeregistrationMsgResponse
+	(*KeepAliveMsg)(nil),              // 21: messages.KeepAliveMsg
+	(*KeepAliveMsgResponse)(nil),      // 22: messages.KeepAliveMsgResponse
+	(*ServiceInstance)(nil),           // 23: messages.ServiceInstance
+	(*ServiceAnnouncement)(nil),       // 24: messages.ServiceAnnouncement
+	(*DiscoveryQuery)(nil),            // 25: messages.DiscoveryQuery
+	(*DiscoverMsg)(nil),               // 26: messages.DiscoverMsg
+	(*DiscoverMsgResponse)(nil),       // 27: messages.DiscoverMsgResponse
+	(*PubMsg)(nil),                    // 28: messages.PubMsg
+	(*PubMsgResponse)(nil),            // 29: messages.PubMsgResponse
+	(*RequestMsg)(nil),                // 30: messages.RequestMsg
+	(*RequestMsgResponse)(nil),        // 31: messages.RequestMsgResponse
+	(*PubAck)(nil),                    // 32: messages.PubAck
+	(*PubJSMsg)(nil),                  // 33: messages.PubJSMsg
+	(*PubJSMsgResponse)(nil),          // 34: messages.PubJSMsgResponse
+	(*PubAcksMsg)(nil),                // 35: messages.PubAcksMsg
+	(*PubAckResult)(nil),              // 36: messages.PubAckResult
+	(*SubMsg)(nil),                    // 37: messages.SubMsg
+	(*SubMsgResponse)(nil),            // 38: messages.SubMsgResponse
+	(*UnsubMsg)(nil),                  // 39: messages.UnsubMsg
+	(*UnsubMsgResponse)(nil),          // 40: messages.UnsubMsgResponse
+	(*UnsubJSMsg)(nil),                // 41: messages.UnsubJSMsg
+	(*UnsubJSMsgResponse)(nil),        // 42: messages.UnsubJSMsgResponse
+	(*Receive)(nil),                   // 43: messages.Receive
+	(*ReceiveJS)(nil),                 // 44: messages.ReceiveJS
+	(*SubTopicResponse)(nil),          // 45: messages.SubTopicResponse
+	(*RespondMsg)(nil),                // 46: messages.RespondMsg
+	(*RespondMsgResponse)(nil),        // 47: messages.RespondMsgResponse
+	(*SubJSTopicResponse)(nil),        // 48: messages.SubJSTopicResponse
+	(*LogMsg)(nil),                    // 49: messages.LogMsg
+	(*LogMsgResponse)(nil),            // 50: messages.LogMsgResponse
+	(*Doc)(nil),                       // 51: messages.Doc
+	(*Documents)(nil),                 // 52: messages.Documents
+	(*StreamControl)(nil),             // 53: messages.StreamControl
+	(*DocStreamOpen)(nil),             // 54: messages.DocStreamOpen
+	(*DocDownload)(nil),               // 55: messages.DocDownload
+	(*AckMsg)(nil),                    // 56: messages.AckMsg
+	(*AckMsgResponse)(nil),            // 57: messages.AckMsgResponse
+	(*DocDownloadResponse)(nil),       // 58: messages.DocDownloadResponse
+	(*DocUpload)(nil),                 // 59: messages.DocUpload
+	(*DocUploadResponse)(nil),         // 60: messages.DocUploadResponse
+	(*HeaderValues)(nil),              // 61: messages.HeaderValues
+	(*Payload)(nil),                   // 62: messages.Payload
+	(*PayloadUpload)(nil),             // 63: messages.PayloadUpload
+	(*PayloadDownload)(nil),           // 64: messages.PayloadDownload
+	(*AddJSMsg)(nil),                  // 65: messages.AddJSMsg
+	(*AddJSMsgResponse)(nil),          // 66: messages.AddJSMsgResponse
+	(*StreamConfig)(nil),              // 67: messages.StreamConfig
+	(*StreamInfo)(nil),                // 68: messages.StreamInfo
+	(*ConsumerConfig)(nil),            // 69: messages.ConsumerConfig
+	(*ConsumerInfo)(nil),              // 70: messages.ConsumerInfo
+	(*StreamMsg)(nil),                 // 71: messages.StreamMsg
+	(*StreamNameMsg)(nil),             // 72: messages.StreamNameMsg
+	(*StreamMsgResponse)(nil),         // 73: messages.StreamMsgResponse
+	(*ConsumerMsg)(nil),               // 74: messages.ConsumerMsg
+	(*ConsumerNameMsg)(nil),           // 75: messages.ConsumerNameMsg
+	(*ConsumerMsgResponse)(nil),       // 76: messages.ConsumerMsgResponse
+	nil,                               // 77: messages.RegistrationParams.TopicDebounceEntry
+	nil,                               // 78: messages.PubMsg.HeadersEntry
+	nil,                               // 79: messages.PubJSMsg.HeadersEntry
+	nil,                               // 80: messages.SubTopicResponse.HeadersEntry
+	nil,                               // 81: messages.SubJSTopicResponse.HeadersEntry
+	nil,                               // 82: messages.DocDownload.HeadersEntry
+	nil,                               // 83: messages.Payload.HeadersEntry
+	(*durationpb.Duration)(nil),       // 84: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 85: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 86: google.protobuf.Empty
 }
 var file_protos_v1_messages_sidecar_proto_depIdxs = []int32{
-	0,  // 0: messages.Header.msgType:type_name -> messages.MsgType
-	34, // 1: messages.RetryBehavior.retryDelay:type_name -> google.protobuf.Duration
-	34, // 2: messages.RegistrationParams.debounceDelay:type_name -> google.protobuf.Duration
-	5,  // 3: messages.RegistrationParams.Retry:type_name -> messages.RetryBehavior
-	3,  // 4: messages.RegistrationMsg.header:type_name -> messages.Header
-	6,  // 5: messages.RegistrationMsg.regParams:type_name -> messages.RegistrationParams
-	3,  // 6: messages.RegistrationMsgResponse.header:type_name -> messages.Header
-	4,  // 7: messages.RegistrationMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	3,  // 8: messages.PubMsg.header:type_name -> messages.Header
-	5,  // 9: messages.PubMsg.Retry:type_name -> messages.RetryBehavior
-	3,  // 10: messages.PubMsgResponse.header:type_name -> messages.Header
-	4,  // 11: messages.PubMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	3,  // 12: messages.PubJSMsg.header:type_name -> messages.Header
-	5,  // 13: messages.PubJSMsg.Retry:type_name -> messages.RetryBehavior
-	3,  // 14: messages.PubJSMsgResponse.header:type_name -> messages.Header
-	4,  // 15: messages.PubJSMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	3,  // 16: messages.SubMsg.header:type_name -> messages.Header
-	3,  // 17: messages.SubMsgResponse.header:type_name -> messages.Header
-	4,  // 18: messages.SubMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	3,  // 19: messages.UnsubMsg.header:type_name -> messages.Header
-	3,  // 20: messages.UnsubMsgResponse.header:type_name -> messages.Header
-	4,  // 21: messages.UnsubMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	3,  // 22: messages.UnsubJSMsg.header:type_name -> messages.Header
-	3,  // 23: messages.UnsubJSMsgResponse.header:type_name -> messages.Header
-	4,  // 24: messages.UnsubJSMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	3,  // 25: messages.Receive.header:type_name -> messages.Header
-	3,  // 26: messages.ReceiveJS.header:type_name -> messages.Header
-	3,  // 27: messages.SubTopicResponse.header:type_name -> messages.Header
-	3,  // 28: messages.SubJSTopicResponse.header:type_name -> messages.Header
-	3,  // 29: messages.LogMsg.header:type_name -> messages.Header
-	3,  // 30: messages.LogMsgResponse.header:type_name -> messages.Header
-	4,  // 31: messages.LogMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	25, // 32: messages.Documents.doc:type_name -> messages.Doc
-	2,  // 33: messages.StreamControl.flow:type_name -> messages.StreamFlow
-	26, // 34: messages.DocDownload.documents:type_name -> messages.Documents
-	27, // 35: messages.DocDownloadResponse.control:type_name -> messages.StreamControl
-	26, // 36: messages.DocUpload.documents:type_name -> messages.Documents
-	27, // 37: messages.DocUploadResponse.control:type_name -> messages.StreamControl
-	3,  // 38: messages.AddJSMsg.header:type_name -> messages.Header
-	3,  // 39: messages.AddJSMsgResponse.header:type_name -> messages.Header
-	4,  // 40: messages.AddJSMsgResponse.rspHeader:type_name -> messages.ResponseHeader
-	7,  // 41: messages.Sidecar.Register:input_type -> messages.RegistrationMsg
-	13, // 42: messages.Sidecar.Sub:input_type -> messages.SubMsg
-	30, // 43: messages.Sidecar.DocUploadStream:input_type -> messages.DocUpload
-	29, // 44: messages.Sidecar.DocDownloadStream:input_type -> messages.DocDownloadResponse
-	19, // 45: messages.Sidecar.Recv:input_type -> messages.Receive
-	20, // 46: messages.Sidecar.RecvJS:input_type -> messages.ReceiveJS
-	15, // 47: messages.Sidecar.Unsub:input_type -> messages.UnsubMsg
-	17, // 48: messages.Sidecar.UnsubJS:input_type -> messages.UnsubJSMsg
-	9,  // 49: messages.Sidecar.Pub:input_type -> messages.PubMsg
-	11, // 50: messages.Sidecar.PubJS:input_type -> messages.PubJSMsg
-	23, // 51: messages.Sidecar.Log:input_type -> messages.LogMsg
-	32, // 52: messages.Sidecar.AddJS:input_type -> messages.AddJSMsg
-	8,  // 53: messages.Sidecar.Register:output_type -> messages.RegistrationMsgResponse
-	14, // 54: messages.Sidecar.Sub:output_type -> messages.SubMsgResponse
-	31, // 55: messages.Sidecar.DocUploadStream:output_type -> messages.DocUploadResponse
-	28, // 56: messages.Sidecar.DocDownloadStream:output_type -> messages.DocDownload
-	21, // 57: messages.Sidecar.Recv:output_type -> messages.SubTopicResponse
-	22, // 58: messages.Sidecar.RecvJS:output_type -> messages.SubJSTopicResponse
-	16, // 59: messages.Sidecar.Unsub:output_type -> messages.UnsubMsgResponse
-	18, // 60: messages.Sidecar.UnsubJS:output_type -> messages.UnsubJSMsgResponse
-	10, // 61: messages.Sidecar.Pub:output_type -> messages.PubMsgResponse
-	35, // 62: messages.Sidecar.PubJS:output_type -> google.protobuf.Empty
-	35, // 63: messages.Sidecar.Log:output_type -> google.protobuf.Empty
-	33, // 64: messages.Sidecar.AddJS:output_type -> messages.AddJSMsgResponse
-	53, // [53:65] is the sub-list for method output_type
-	41, // [41:53] is the sub-list for method input_type
-	41, // [41:41] is the sub-list for extension type_name
-	41, // [41:41] is the sub-list for extension extendee
-	0,  // [0:41] is the sub-list for field type_name
+	0,   // 0: messages.Header.msgType:type_name -> messages.MsgType
+	84,  // 1: messages.RetryBehavior.retryDelay:type_name -> google.protobuf.Duration
+	84,  // 2: messages.RetryBehavior.maxDelay:type_name -> google.protobuf.Duration
+	84,  // 3: messages.RetryBehavior.attemptTimeout:type_name -> google.protobuf.Duration
+	2,   // 4: messages.RetryBehavior.retryOn:type_name -> messages.RetryableError
+	84,  // 5: messages.CircuitBreakerParams.openTimeout:type_name -> google.protobuf.Duration
+	84,  // 6: messages.CircuitBreakerParams.maxOpenTimeout:type_name -> google.protobuf.Duration
+	84,  // 7: messages.CircuitBreakerParams.failureRateWindow:type_name -> google.protobuf.Duration
+	3,   // 8: messages.TopicDebounce.mode:type_name -> messages.DebounceMode
+	84,  // 9: messages.TopicDebounce.delay:type_name -> google.protobuf.Duration
+	84,  // 10: messages.RateLimit.maxWait:type_name -> google.protobuf.Duration
+	84,  // 11: messages.RegistrationParams.debounceDelay:type_name -> google.protobuf.Duration
+	12,  // 12: messages.RegistrationParams.Retry:type_name -> messages.RetryBehavior
+	84,  // 13: messages.RegistrationParams.leaseTTL:type_name -> google.protobuf.Duration
+	13,  // 14: messages.RegistrationParams.circuitBreaker:type_name -> messages.CircuitBreakerParams
+	3,   // 15: messages.RegistrationParams.debounceMode:type_name -> messages.DebounceMode
+	77,  // 16: messages.RegistrationParams.topicDebounce:type_name -> messages.RegistrationParams.TopicDebounceEntry
+	15,  // 17: messages.RegistrationParams.rateLimit:type_name -> messages.RateLimit
+	15,  // 18: messages.RegistrationParams.topicRateLimit:type_name -> messages.RateLimit
+	10,  // 19: messages.RegistrationMsg.header:type_name -> messages.Header
+	16,  // 20: messages.RegistrationMsg.regParams:type_name -> messages.RegistrationParams
+	10,  // 21: messages.RegistrationMsgResponse.header:type_name -> messages.Header
+	11,  // 22: messages.RegistrationMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	85,  // 23: messages.RegistrationMsgResponse.sessionExpiresAt:type_name -> google.protobuf.Timestamp
+	10,  // 24: messages.DeregistrationMsg.header:type_name -> messages.Header
+	10,  // 25: messages.DeregistrationMsgResponse.header:type_name -> messages.Header
+	11,  // 26: messages.DeregistrationMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	10,  // 27: messages.KeepAliveMsg.header:type_name -> messages.Header
+	10,  // 28: messages.KeepAliveMsgResponse.header:type_name -> messages.Header
+	11,  // 29: messages.KeepAliveMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	84,  // 30: messages.KeepAliveMsgResponse.leaseTTL:type_name -> google.protobuf.Duration
+	85,  // 31: messages.KeepAliveMsgResponse.sessionExpiresAt:type_name -> google.protobuf.Timestamp
+	85,  // 32: messages.ServiceInstance.registeredAt:type_name -> google.protobuf.Timestamp
+	85,  // 33: messages.ServiceInstance.lastSeen:type_name -> google.protobuf.Timestamp
+	23,  // 34: messages.ServiceAnnouncement.instances:type_name -> messages.ServiceInstance
+	10,  // 35: messages.DiscoverMsg.header:type_name -> messages.Header
+	10,  // 36: messages.DiscoverMsgResponse.header:type_name -> messages.Header
+	11,  // 37: messages.DiscoverMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	23,  // 38: messages.DiscoverMsgResponse.instances:type_name -> messages.ServiceInstance
+	10,  // 39: messages.PubMsg.header:type_name -> messages.Header
+	12,  // 40: messages.PubMsg.Retry:type_name -> messages.RetryBehavior
+	78,  // 41: messages.PubMsg.headers:type_name -> messages.PubMsg.HeadersEntry
+	10,  // 42: messages.PubMsgResponse.header:type_name -> messages.Header
+	11,  // 43: messages.PubMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	10,  // 44: messages.RequestMsg.header:type_name -> messages.Header
+	12,  // 45: messages.RequestMsg.Retry:type_name -> messages.RetryBehavior
+	84,  // 46: messages.RequestMsg.timeout:type_name -> google.protobuf.Duration
+	10,  // 47: messages.RequestMsgResponse.header:type_name -> messages.Header
+	11,  // 48: messages.RequestMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	84,  // 49: messages.RequestMsgResponse.retryAfter:type_name -> google.protobuf.Duration
+	10,  // 50: messages.PubJSMsg.header:type_name -> messages.Header
+	12,  // 51: messages.PubJSMsg.Retry:type_name -> messages.RetryBehavior
+	4,   // 52: messages.PubJSMsg.ackMode:type_name -> messages.PubAckMode
+	79,  // 53: messages.PubJSMsg.headers:type_name -> messages.PubJSMsg.HeadersEntry
+	10,  // 54: messages.PubJSMsgResponse.header:type_name -> messages.Header
+	11,  // 55: messages.PubJSMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	32,  // 56: messages.PubJSMsgResponse.pubAck:type_name -> messages.PubAck
+	10,  // 57: messages.PubAcksMsg.header:type_name -> messages.Header
+	10,  // 58: messages.PubAckResult.header:type_name -> messages.Header
+	32,  // 59: messages.PubAckResult.pubAck:type_name -> messages.PubAck
+	10,  // 60: messages.SubMsg.header:type_name -> messages.Header
+	5,   // 61: messages.SubMsg.overflow:type_name -> messages.OverflowPolicy
+	10,  // 62: messages.SubMsgResponse.header:type_name -> messages.Header
+	11,  // 63: messages.SubMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	10,  // 64: messages.UnsubMsg.header:type_name -> messages.Header
+	10,  // 65: messages.UnsubMsgResponse.header:type_name -> messages.Header
+	11,  // 66: messages.UnsubMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	10,  // 67: messages.UnsubJSMsg.header:type_name -> messages.Header
+	10,  // 68: messages.UnsubJSMsgResponse.header:type_name -> messages.Header
+	11,  // 69: messages.UnsubJSMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	10,  // 70: messages.Receive.header:type_name -> messages.Header
+	10,  // 71: messages.ReceiveJS.header:type_name -> messages.Header
+	10,  // 72: messages.SubTopicResponse.header:type_name -> messages.Header
+	80,  // 73: messages.SubTopicResponse.headers:type_name -> messages.SubTopicResponse.HeadersEntry
+	10,  // 74: messages.RespondMsg.header:type_name -> messages.Header
+	10,  // 75: messages.RespondMsgResponse.header:type_name -> messages.Header
+	11,  // 76: messages.RespondMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	10,  // 77: messages.SubJSTopicResponse.header:type_name -> messages.Header
+	81,  // 78: messages.SubJSTopicResponse.headers:type_name -> messages.SubJSTopicResponse.HeadersEntry
+	10,  // 79: messages.LogMsg.header:type_name -> messages.Header
+	10,  // 80: messages.LogMsgResponse.header:type_name -> messages.Header
+	11,  // 81: messages.LogMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	51,  // 82: messages.Documents.doc:type_name -> messages.Doc
+	6,   // 83: messages.StreamControl.flow:type_name -> messages.StreamFlow
+	10,  // 84: messages.DocStreamOpen.header:type_name -> messages.Header
+	52,  // 85: messages.DocDownload.documents:type_name -> messages.Documents
+	82,  // 86: messages.DocDownload.headers:type_name -> messages.DocDownload.HeadersEntry
+	10,  // 87: messages.AckMsg.header:type_name -> messages.Header
+	84,  // 88: messages.AckMsg.nakDelay:type_name -> google.protobuf.Duration
+	10,  // 89: messages.AckMsgResponse.header:type_name -> messages.Header
+	11,  // 90: messages.AckMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	53,  // 91: messages.DocDownloadResponse.control:type_name -> messages.StreamControl
+	54,  // 92: messages.DocDownloadResponse.open:type_name -> messages.DocStreamOpen
+	52,  // 93: messages.DocUpload.documents:type_name -> messages.Documents
+	54,  // 94: messages.DocUpload.open:type_name -> messages.DocStreamOpen
+	53,  // 95: messages.DocUploadResponse.control:type_name -> messages.StreamControl
+	32,  // 96: messages.DocUploadResponse.pubAck:type_name -> messages.PubAck
+	83,  // 97: messages.Payload.headers:type_name -> messages.Payload.HeadersEntry
+	54,  // 98: messages.PayloadUpload.open:type_name -> messages.DocStreamOpen
+	62,  // 99: messages.PayloadUpload.payload:type_name -> messages.Payload
+	62,  // 100: messages.PayloadDownload.payload:type_name -> messages.Payload
+	10,  // 101: messages.AddJSMsg.header:type_name -> messages.Header
+	10,  // 102: messages.AddJSMsgResponse.header:type_name -> messages.Header
+	11,  // 103: messages.AddJSMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	7,   // 104: messages.StreamConfig.retention:type_name -> messages.RetentionPolicy
+	8,   // 105: messages.StreamConfig.storage:type_name -> messages.StorageType
+	84,  // 106: messages.StreamConfig.maxAge:type_name -> google.protobuf.Duration
+	84,  // 107: messages.StreamConfig.duplicateWindow:type_name -> google.protobuf.Duration
+	67,  // 108: messages.StreamInfo.config:type_name -> messages.StreamConfig
+	85,  // 109: messages.StreamInfo.created:type_name -> google.protobuf.Timestamp
+	9,   // 110: messages.ConsumerConfig.ackPolicy:type_name -> messages.AckPolicy
+	84,  // 111: messages.ConsumerConfig.ackWait:type_name -> google.protobuf.Duration
+	69,  // 112: messages.ConsumerInfo.config:type_name -> messages.ConsumerConfig
+	85,  // 113: messages.ConsumerInfo.created:type_name -> google.protobuf.Timestamp
+	10,  // 114: messages.StreamMsg.header:type_name -> messages.Header
+	67,  // 115: messages.StreamMsg.config:type_name -> messages.StreamConfig
+	10,  // 116: messages.StreamNameMsg.header:type_name -> messages.Header
+	10,  // 117: messages.StreamMsgResponse.header:type_name -> messages.Header
+	11,  // 118: messages.StreamMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	68,  // 119: messages.StreamMsgResponse.info:type_name -> messages.StreamInfo
+	10,  // 120: messages.ConsumerMsg.header:type_name -> messages.Header
+	69,  // 121: messages.ConsumerMsg.config:type_name -> messages.ConsumerConfig
+	10,  // 122: messages.ConsumerNameMsg.header:type_name -> messages.Header
+	10,  // 123: messages.ConsumerMsgResponse.header:type_name -> messages.Header
+	11,  // 124: messages.ConsumerMsgResponse.rspHeader:type_name -> messages.ResponseHeader
+	70,  // 125: messages.ConsumerMsgResponse.info:type_name -> messages.ConsumerInfo
+	14,  // 126: messages.RegistrationParams.TopicDebounceEntry.value:type_name -> messages.TopicDebounce
+	61,  // 127: messages.PubMsg.HeadersEntry.value:type_name -> messages.HeaderValues
+	61,  // 128: messages.PubJSMsg.HeadersEntry.value:type_name -> messages.HeaderValues
+	61,  // 129: messages.SubTopicResponse.HeadersEntry.value:type_name -> messages.HeaderValues
+	61,  // 130: messages.SubJSTopicResponse.HeadersEntry.value:type_name -> messages.HeaderValues
+	61,  // 131: messages.DocDownload.HeadersEntry.value:type_name -> messages.HeaderValues
+	61,  // 132: messages.Payload.HeadersEntry.value:type_name -> messages.HeaderValues
+	17,  // 133: messages.Sidecar.Register:input_type -> messages.RegistrationMsg
+	19,  // 134: messages.Sidecar.Deregister:input_type -> messages.DeregistrationMsg
+	21,  // 135: messages.Sidecar.KeepAlive:input_type -> messages.KeepAliveMsg
+	26,  // 136: messages.Sidecar.Discover:input_type -> messages.DiscoverMsg
+	37,  // 137: messages.Sidecar.Sub:input_type -> messages.SubMsg
+	59,  // 138: messages.Sidecar.DocUploadStream:input_type -> messages.DocUpload
+	58,  // 139: messages.Sidecar.DocDownloadStream:input_type -> messages.DocDownloadResponse
+	63,  // 140: messages.Sidecar.PayloadUploadStream:input_type -> messages.PayloadUpload
+	58,  // 141: messages.Sidecar.PayloadDownloadStream:input_type -> messages.DocDownloadResponse
+	43,  // 142: messages.Sidecar.Recv:input_type -> messages.Receive
+	37,  // 143: messages.Sidecar.Subscribe:input_type -> messages.SubMsg
+	44,  // 144: messages.Sidecar.RecvJS:input_type -> messages.ReceiveJS
+	39,  // 145: messages.Sidecar.Unsub:input_type -> messages.UnsubMsg
+	41,  // 146: messages.Sidecar.UnsubJS:input_type -> messages.UnsubJSMsg
+	28,  // 147: messages.Sidecar.Pub:input_type -> messages.PubMsg
+	30,  // 148: messages.Sidecar.Request:input_type -> messages.RequestMsg
+	46,  // 149: messages.Sidecar.Respond:input_type -> messages.RespondMsg
+	33,  // 150: messages.Sidecar.PubJS:input_type -> messages.PubJSMsg
+	35,  // 151: messages.Sidecar.PubAcks:input_type -> messages.PubAcksMsg
+	49,  // 152: messages.Sidecar.Log:input_type -> messages.LogMsg
+	65,  // 153: messages.Sidecar.AddJS:input_type -> messages.AddJSMsg
+	56,  // 154: messages.Sidecar.Ack:input_type -> messages.AckMsg
+	56,  // 155: messages.Sidecar.Nak:input_type -> messages.AckMsg
+	56,  // 156: messages.Sidecar.InProgress:input_type -> messages.AckMsg
+	56,  // 157: messages.Sidecar.Term:input_type -> messages.AckMsg
+	71,  // 158: messages.Sidecar.AddStream:input_type -> messages.StreamMsg
+	71,  // 159: messages.Sidecar.UpdateStream:input_type -> messages.StreamMsg
+	72,  // 160: messages.Sidecar.DeleteStream:input_type -> messages.StreamNameMsg
+	72,  // 161: messages.Sidecar.GetStreamInfo:input_type -> messages.StreamNameMsg
+	74,  // 162: messages.Sidecar.AddConsumer:input_type -> messages.ConsumerMsg
+	74,  // 163: messages.Sidecar.UpdateConsumer:input_type -> messages.ConsumerMsg
+	75,  // 164: messages.Sidecar.DeleteConsumer:input_type -> messages.ConsumerNameMsg
+	75,  // 165: messages.Sidecar.GetConsumerInfo:input_type -> messages.ConsumerNameMsg
+	18,  // 166: messages.Sidecar.Register:output_type -> messages.RegistrationMsgResponse
+	20,  // 167: messages.Sidecar.Deregister:output_type -> messages.DeregistrationMsgResponse
+	22,  // 168: messages.Sidecar.KeepAlive:output_type -> messages.KeepAliveMsgResponse
+	27,  // 169: messages.Sidecar.Discover:output_type -> messages.DiscoverMsgResponse
+	38,  // 170: messages.Sidecar.Sub:output_type -> messages.SubMsgResponse
+	60,  // 171: messages.Sidecar.DocUploadStream:output_type -> messages.DocUploadResponse
+	55,  // 172: messages.Sidecar.DocDownloadStream:output_type -> messages.DocDownload
+	60,  // 173: messages.Sidecar.PayloadUploadStream:output_type -> messages.DocUploadResponse
+	64,  // 174: messages.Sidecar.PayloadDownloadStream:output_type -> messages.PayloadDownload
+	45,  // 175: messages.Sidecar.Recv:output_type -> messages.SubTopicResponse
+	45,  // 176: messages.Sidecar.Subscribe:output_type -> messages.SubTopicResponse
+	48,  // 177: messages.Sidecar.RecvJS:output_type -> messages.SubJSTopicResponse
+	40,  // 178: messages.Sidecar.Unsub:output_type -> messages.UnsubMsgResponse
+	42,  // 179: messages.Sidecar.UnsubJS:output_type -> messages.UnsubJSMsgResponse
+	29,  // 180: messages.Sidecar.Pub:output_type -> messages.PubMsgResponse
+	31,  // 181: messages.Sidecar.Request:output_type -> messages.RequestMsgResponse
+	47,  // 182: messages.Sidecar.Respond:output_type -> messages.RespondMsgResponse
+	34,  // 183: messages.Sidecar.PubJS:output_type -> messages.PubJSMsgResponse
+	36,  // 184: messages.Sidecar.PubAcks:output_type -> messages.PubAckResult
+	86,  // 185: messages.Sidecar.Log:output_type -> google.protobuf.Empty
+	66,  // 186: messages.Sidecar.AddJS:output_type -> messages.AddJSMsgResponse
+	57,  // 187: messages.Sidecar.Ack:output_type -> messages.AckMsgResponse
+	57,  // 188: messages.Sidecar.Nak:output_type -> messages.AckMsgResponse
+	57,  // 189: messages.Sidecar.InProgress:output_type -> messages.AckMsgResponse
+	57,  // 190: messages.Sidecar.Term:output_type -> messages.AckMsgResponse
+	73,  // 191: messages.Sidecar.AddStream:output_type -> messages.StreamMsgResponse
+	73,  // 192: messages.Sidecar.UpdateStream:output_type -> messages.StreamMsgResponse
+	73,  // 193: messages.Sidecar.DeleteStream:output_type -> messages.StreamMsgResponse
+	73,  // 194: messages.Sidecar.GetStreamInfo:output_type -> messages.StreamMsgResponse
+	76,  // 195: messages.Sidecar.AddConsumer:output_type -> messages.ConsumerMsgResponse
+	76,  // 196: messages.Sidecar.UpdateConsumer:output_type -> messages.ConsumerMsgResponse
+	76,  // 197: messages.Sidecar.DeleteConsumer:output_type -> messages.ConsumerMsgResponse
+	76,  // 198: messages.Sidecar.GetConsumerInfo:output_type -> messages.ConsumerMsgResponse
+	166, // [166:199] is the sub-list for method output_type
+	133, // [133:166] is the sub-list for method input_type
+	133, // [133:133] is the sub-list for extension type_name
+	133, // [133:133] is the sub-list for extension extendee
+	0,   // [0:133] is the sub-list for field type_name
 }
 
 func init() { file_protos_v1_messages_sidecar_proto_init() }
@@ -2706,8 +6940,212 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseHeader); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryBehavior); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreakerParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopicDebounce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregistrationMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregistrationMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepAliveMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeepAliveMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceAnnouncement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoveryQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2718,8 +7156,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetryBehavior); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubMsgResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2730,8 +7168,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrationParams); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2742,8 +7180,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrationMsg); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestMsgResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2754,8 +7192,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegistrationMsgResponse); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2766,8 +7204,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubMsg); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubJSMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2778,8 +7216,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubMsgResponse); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubJSMsgResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2790,8 +7228,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubJSMsg); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubAcksMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2802,8 +7240,8 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubJSMsgResponse); i {
+		file_protos_v1_messages_sidecar_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubAckResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2814,7 +7252,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubMsg); i {
 			case 0:
 				return &v.state
@@ -2826,7 +7264,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubMsgResponse); i {
 			case 0:
 				return &v.state
@@ -2838,7 +7276,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsubMsg); i {
 			case 0:
 				return &v.state
@@ -2850,7 +7288,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsubMsgResponse); i {
 			case 0:
 				return &v.state
@@ -2862,7 +7300,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsubJSMsg); i {
 			case 0:
 				return &v.state
@@ -2874,7 +7312,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsubJSMsgResponse); i {
 			case 0:
 				return &v.state
@@ -2886,7 +7324,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Receive); i {
 			case 0:
 				return &v.state
@@ -2898,7 +7336,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReceiveJS); i {
 			case 0:
 				return &v.state
@@ -2910,7 +7348,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubTopicResponse); i {
 			case 0:
 				return &v.state
@@ -2922,7 +7360,31 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RespondMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubJSTopicResponse); i {
 			case 0:
 				return &v.state
@@ -2934,7 +7396,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogMsg); i {
 			case 0:
 				return &v.state
@@ -2946,7 +7408,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogMsgResponse); i {
 			case 0:
 				return &v.state
@@ -2958,7 +7420,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Doc); i {
 			case 0:
 				return &v.state
@@ -2970,7 +7432,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Documents); i {
 			case 0:
 				return &v.state
@@ -2982,7 +7444,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamControl); i {
 			case 0:
 				return &v.state
@@ -2994,7 +7456,19 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DocStreamOpen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DocDownload); i {
 			case 0:
 				return &v.state
@@ -3006,7 +7480,31 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DocDownloadResponse); i {
 			case 0:
 				return &v.state
@@ -3018,7 +7516,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DocUpload); i {
 			case 0:
 				return &v.state
@@ -3030,7 +7528,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DocUploadResponse); i {
 			case 0:
 				return &v.state
@@ -3042,7 +7540,55 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeaderValues); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayloadUpload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayloadDownload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddJSMsg); i {
 			case 0:
 				return &v.state
@@ -3054,7 +7600,7 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
-		file_protos_v1_messages_sidecar_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_protos_v1_messages_sidecar_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddJSMsgResponse); i {
 			case 0:
 				return &v.state
@@ -3066,14 +7612,134 @@ func file_protos_v1_messages_sidecar_proto_init() {
 				return nil
 			}
 		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamNameMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerNameMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_v1_messages_sidecar_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsumerMsgResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_v1_messages_sidecar_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   31,
+			NumEnums:      10,
+			NumMessages:   74,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,17 +24,47 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SidecarClient interface {
 	Register(ctx context.Context, in *RegistrationMsg, opts ...grpc.CallOption) (*RegistrationMsgResponse, error)
+	Deregister(ctx context.Context, in *DeregistrationMsg, opts ...grpc.CallOption) (*DeregistrationMsgResponse, error)
+	KeepAlive(ctx context.Context, in *KeepAliveMsg, opts ...grpc.CallOption) (*KeepAliveMsgResponse, error)
+	Discover(ctx context.Context, in *DiscoverMsg, opts ...grpc.CallOption) (*DiscoverMsgResponse, error)
 	Sub(ctx context.Context, in *SubMsg, opts ...grpc.CallOption) (*SubMsgResponse, error)
 	DocUploadStream(ctx context.Context, opts ...grpc.CallOption) (Sidecar_DocUploadStreamClient, error)
 	DocDownloadStream(ctx context.Context, opts ...grpc.CallOption) (Sidecar_DocDownloadStreamClient, error)
+	// Like DocUploadStream and DocDownloadStream, for any payload.
+	PayloadUploadStream(ctx context.Context, opts ...grpc.CallOption) (Sidecar_PayloadUploadStreamClient, error)
+	PayloadDownloadStream(ctx context.Context, opts ...grpc.CallOption) (Sidecar_PayloadDownloadStreamClient, error)
 	Recv(ctx context.Context, in *Receive, opts ...grpc.CallOption) (*SubTopicResponse, error)
+	// Subscribe streams the messages on a topic. The stream has its own
+	// subscription, which ends with the stream, unless SubMsg names one made
+	// earlier with Sub. The stream ends when the client cancels it, or when
+	// its subscription is unsubscribed. Use it instead of polling Recv.
+	Subscribe(ctx context.Context, in *SubMsg, opts ...grpc.CallOption) (Sidecar_SubscribeClient, error)
 	RecvJS(ctx context.Context, in *ReceiveJS, opts ...grpc.CallOption) (*SubJSTopicResponse, error)
 	Unsub(ctx context.Context, in *UnsubMsg, opts ...grpc.CallOption) (*UnsubMsgResponse, error)
 	UnsubJS(ctx context.Context, in *UnsubJSMsg, opts ...grpc.CallOption) (*UnsubJSMsgResponse, error)
 	Pub(ctx context.Context, in *PubMsg, opts ...grpc.CallOption) (*PubMsgResponse, error)
-	PubJS(ctx context.Context, in *PubJSMsg, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Request(ctx context.Context, in *RequestMsg, opts ...grpc.CallOption) (*RequestMsgResponse, error)
+	Respond(ctx context.Context, in *RespondMsg, opts ...grpc.CallOption) (*RespondMsgResponse, error)
+	PubJS(ctx context.Context, in *PubJSMsg, opts ...grpc.CallOption) (*PubJSMsgResponse, error)
+	PubAcks(ctx context.Context, in *PubAcksMsg, opts ...grpc.CallOption) (Sidecar_PubAcksClient, error)
 	Log(ctx context.Context, in *LogMsg, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddJS(ctx context.Context, in *AddJSMsg, opts ...grpc.CallOption) (*AddJSMsgResponse, error)
+	// Settle a message from DocDownloadStream. Ack when done with it, Nak
+	// to have it delivered again, InProgress for more time to work on it,
+	// and Term to never have it delivered again.
+	Ack(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error)
+	Nak(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error)
+	InProgress(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error)
+	Term(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error)
+	// Manage JetStream streams and their durable consumers.
+	AddStream(ctx context.Context, in *StreamMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error)
+	UpdateStream(ctx context.Context, in *StreamMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error)
+	DeleteStream(ctx context.Context, in *StreamNameMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error)
+	GetStreamInfo(ctx context.Context, in *StreamNameMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error)
+	AddConsumer(ctx context.Context, in *ConsumerMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error)
+	UpdateConsumer(ctx context.Context, in *ConsumerMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error)
+	DeleteConsumer(ctx context.Context, in *ConsumerNameMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error)
+	GetConsumerInfo(ctx context.Context, in *ConsumerNameMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error)
 }
 
 type sidecarClient struct {
@@ -54,6 +84,33 @@ func (c *sidecarClient) Register(ctx context.Context, in *RegistrationMsg, opts
 	return out, nil
 }
 
+func (c *sidecarClient) Deregister(ctx context.Context, in *DeregistrationMsg, opts ...grpc.CallOption) (*DeregistrationMsgResponse, error) {
+	out := new(DeregistrationMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/Deregister", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) KeepAlive(ctx context.Context, in *KeepAliveMsg, opts ...grpc.CallOption) (*KeepAliveMsgResponse, error) {
+	out := new(KeepAliveMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/KeepAlive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) Discover(ctx context.Context, in *DiscoverMsg, opts ...grpc.CallOption) (*DiscoverMsgResponse, error) {
+	out := new(DiscoverMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/Discover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sidecarClient) Sub(ctx context.Context, in *SubMsg, opts ...grpc.CallOption) (*SubMsgResponse, error) {
 	out := new(SubMsgResponse)
 	err := c.cc.Invoke(ctx, "/messages.Sidecar/Sub", in, out, opts...)
@@ -125,6 +182,68 @@ func (x *sidecarDocDownloadStreamClient) Recv() (*DocDownload, error) {
 	return m, nil
 }
 
+func (c *sidecarClient) PayloadUploadStream(ctx context.Context, opts ...grpc.CallOption) (Sidecar_PayloadUploadStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sidecar_ServiceDesc.Streams[2], "/messages.Sidecar/PayloadUploadStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sidecarPayloadUploadStreamClient{stream}
+	return x, nil
+}
+
+type Sidecar_PayloadUploadStreamClient interface {
+	Send(*PayloadUpload) error
+	Recv() (*DocUploadResponse, error)
+	grpc.ClientStream
+}
+
+type sidecarPayloadUploadStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *sidecarPayloadUploadStreamClient) Send(m *PayloadUpload) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sidecarPayloadUploadStreamClient) Recv() (*DocUploadResponse, error) {
+	m := new(DocUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sidecarClient) PayloadDownloadStream(ctx context.Context, opts ...grpc.CallOption) (Sidecar_PayloadDownloadStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sidecar_ServiceDesc.Streams[3], "/messages.Sidecar/PayloadDownloadStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sidecarPayloadDownloadStreamClient{stream}
+	return x, nil
+}
+
+type Sidecar_PayloadDownloadStreamClient interface {
+	Send(*DocDownloadResponse) error
+	Recv() (*PayloadDownload, error)
+	grpc.ClientStream
+}
+
+type sidecarPayloadDownloadStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *sidecarPayloadDownloadStreamClient) Send(m *DocDownloadResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *sidecarPayloadDownloadStreamClient) Recv() (*PayloadDownload, error) {
+	m := new(PayloadDownload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sidecarClient) Recv(ctx context.Context, in *Receive, opts ...grpc.CallOption) (*SubTopicResponse, error) {
 	out := new(SubTopicResponse)
 	err := c.cc.Invoke(ctx, "/messages.Sidecar/Recv", in, out, opts...)
@@ -134,6 +253,38 @@ func (c *sidecarClient) Recv(ctx context.Context, in *Receive, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *sidecarClient) Subscribe(ctx context.Context, in *SubMsg, opts ...grpc.CallOption) (Sidecar_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sidecar_ServiceDesc.Streams[4], "/messages.Sidecar/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sidecarSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sidecar_SubscribeClient interface {
+	Recv() (*SubTopicResponse, error)
+	grpc.ClientStream
+}
+
+type sidecarSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *sidecarSubscribeClient) Recv() (*SubTopicResponse, error) {
+	m := new(SubTopicResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sidecarClient) RecvJS(ctx context.Context, in *ReceiveJS, opts ...grpc.CallOption) (*SubJSTopicResponse, error) {
 	out := new(SubJSTopicResponse)
 	err := c.cc.Invoke(ctx, "/messages.Sidecar/RecvJS", in, out, opts...)
@@ -170,8 +321,26 @@ func (c *sidecarClient) Pub(ctx context.Context, in *PubMsg, opts ...grpc.CallOp
 	return out, nil
 }
 
-func (c *sidecarClient) PubJS(ctx context.Context, in *PubJSMsg, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *sidecarClient) Request(ctx context.Context, in *RequestMsg, opts ...grpc.CallOption) (*RequestMsgResponse, error) {
+	out := new(RequestMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/Request", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) Respond(ctx context.Context, in *RespondMsg, opts ...grpc.CallOption) (*RespondMsgResponse, error) {
+	out := new(RespondMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/Respond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) PubJS(ctx context.Context, in *PubJSMsg, opts ...grpc.CallOption) (*PubJSMsgResponse, error) {
+	out := new(PubJSMsgResponse)
 	err := c.cc.Invoke(ctx, "/messages.Sidecar/PubJS", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -179,6 +348,38 @@ func (c *sidecarClient) PubJS(ctx context.Context, in *PubJSMsg, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *sidecarClient) PubAcks(ctx context.Context, in *PubAcksMsg, opts ...grpc.CallOption) (Sidecar_PubAcksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sidecar_ServiceDesc.Streams[5], "/messages.Sidecar/PubAcks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sidecarPubAcksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sidecar_PubAcksClient interface {
+	Recv() (*PubAckResult, error)
+	grpc.ClientStream
+}
+
+type sidecarPubAcksClient struct {
+	grpc.ClientStream
+}
+
+func (x *sidecarPubAcksClient) Recv() (*PubAckResult, error) {
+	m := new(PubAckResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *sidecarClient) Log(ctx context.Context, in *LogMsg, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/messages.Sidecar/Log", in, out, opts...)
@@ -197,22 +398,160 @@ func (c *sidecarClient) AddJS(ctx context.Context, in *AddJSMsg, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *sidecarClient) Ack(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error) {
+	out := new(AckMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/Ack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) Nak(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error) {
+	out := new(AckMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/Nak", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) InProgress(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error) {
+	out := new(AckMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/InProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) Term(ctx context.Context, in *AckMsg, opts ...grpc.CallOption) (*AckMsgResponse, error) {
+	out := new(AckMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/Term", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) AddStream(ctx context.Context, in *StreamMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error) {
+	out := new(StreamMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/AddStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) UpdateStream(ctx context.Context, in *StreamMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error) {
+	out := new(StreamMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/UpdateStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) DeleteStream(ctx context.Context, in *StreamNameMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error) {
+	out := new(StreamMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/DeleteStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) GetStreamInfo(ctx context.Context, in *StreamNameMsg, opts ...grpc.CallOption) (*StreamMsgResponse, error) {
+	out := new(StreamMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/GetStreamInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) AddConsumer(ctx context.Context, in *ConsumerMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error) {
+	out := new(ConsumerMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/AddConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) UpdateConsumer(ctx context.Context, in *ConsumerMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error) {
+	out := new(ConsumerMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/UpdateConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) DeleteConsumer(ctx context.Context, in *ConsumerNameMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error) {
+	out := new(ConsumerMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/DeleteConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarClient) GetConsumerInfo(ctx context.Context, in *ConsumerNameMsg, opts ...grpc.CallOption) (*ConsumerMsgResponse, error) {
+	out := new(ConsumerMsgResponse)
+	err := c.cc.Invoke(ctx, "/messages.Sidecar/GetConsumerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SidecarServer is the server API for Sidecar service.
 // All implementations must embed UnimplementedSidecarServer
 // for forward compatibility
 type SidecarServer interface {
 	Register(context.Context, *RegistrationMsg) (*RegistrationMsgResponse, error)
+	Deregister(context.Context, *DeregistrationMsg) (*DeregistrationMsgResponse, error)
+	KeepAlive(context.Context, *KeepAliveMsg) (*KeepAliveMsgResponse, error)
+	Discover(context.Context, *DiscoverMsg) (*DiscoverMsgResponse, error)
 	Sub(context.Context, *SubMsg) (*SubMsgResponse, error)
 	DocUploadStream(Sidecar_DocUploadStreamServer) error
 	DocDownloadStream(Sidecar_DocDownloadStreamServer) error
+	// Like DocUploadStream and DocDownloadStream, for any payload.
+	PayloadUploadStream(Sidecar_PayloadUploadStreamServer) error
+	PayloadDownloadStream(Sidecar_PayloadDownloadStreamServer) error
 	Recv(context.Context, *Receive) (*SubTopicResponse, error)
+	// Subscribe streams the messages on a topic. The stream has its own
+	// subscription, which ends with the stream, unless SubMsg names one made
+	// earlier with Sub. The stream ends when the client cancels it, or when
+	// its subscription is unsubscribed. Use it instead of polling Recv.
+	Subscribe(*SubMsg, Sidecar_SubscribeServer) error
 	RecvJS(context.Context, *ReceiveJS) (*SubJSTopicResponse, error)
 	Unsub(context.Context, *UnsubMsg) (*UnsubMsgResponse, error)
 	UnsubJS(context.Context, *UnsubJSMsg) (*UnsubJSMsgResponse, error)
 	Pub(context.Context, *PubMsg) (*PubMsgResponse, error)
-	PubJS(context.Context, *PubJSMsg) (*emptypb.Empty, error)
+	Request(context.Context, *RequestMsg) (*RequestMsgResponse, error)
+	Respond(context.Context, *RespondMsg) (*RespondMsgResponse, error)
+	PubJS(context.Context, *PubJSMsg) (*PubJSMsgResponse, error)
+	PubAcks(*PubAcksMsg, Sidecar_PubAcksServer) error
 	Log(context.Context, *LogMsg) (*emptypb.Empty, error)
 	AddJS(context.Context, *AddJSMsg) (*AddJSMsgResponse, error)
+	// Settle a message from DocDownloadStream. Ack when done with it, Nak
+	// to have it delivered again, InProgress for more time to work on it,
+	// and Term to never have it delivered again.
+	Ack(context.Context, *AckMsg) (*AckMsgResponse, error)
+	Nak(context.Context, *AckMsg) (*AckMsgResponse, error)
+	InProgress(context.Context, *AckMsg) (*AckMsgResponse, error)
+	Term(context.Context, *AckMsg) (*AckMsgResponse, error)
+	// Manage JetStream streams and their durable consumers.
+	AddStream(context.Context, *StreamMsg) (*StreamMsgResponse, error)
+	UpdateStream(context.Context, *StreamMsg) (*StreamMsgResponse, error)
+	DeleteStream(context.Context, *StreamNameMsg) (*StreamMsgResponse, error)
+	GetStreamInfo(context.Context, *StreamNameMsg) (*StreamMsgResponse, error)
+	AddConsumer(context.Context, *ConsumerMsg) (*ConsumerMsgResponse, error)
+	UpdateConsumer(context.Context, *ConsumerMsg) (*ConsumerMsgResponse, error)
+	DeleteConsumer(context.Context, *ConsumerNameMsg) (*ConsumerMsgResponse, error)
+	GetConsumerInfo(context.Context, *ConsumerNameMsg) (*ConsumerMsgResponse, error)
 	mustEmbedUnimplementedSidecarServer()
 }
 
@@ -223,6 +562,15 @@ type UnimplementedSidecarServer struct {
 func (UnimplementedSidecarServer) Register(context.Context, *RegistrationMsg) (*RegistrationMsgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedSidecarServer) Deregister(context.Context, *DeregistrationMsg) (*DeregistrationMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deregister not implemented")
+}
+func (UnimplementedSidecarServer) KeepAlive(context.Context, *KeepAliveMsg) (*KeepAliveMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeepAlive not implemented")
+}
+func (UnimplementedSidecarServer) Discover(context.Context, *DiscoverMsg) (*DiscoverMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Discover not implemented")
+}
 func (UnimplementedSidecarServer) Sub(context.Context, *SubMsg) (*SubMsgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sub not implemented")
 }
@@ -232,9 +580,18 @@ func (UnimplementedSidecarServer) DocUploadStream(Sidecar_DocUploadStreamServer)
 func (UnimplementedSidecarServer) DocDownloadStream(Sidecar_DocDownloadStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method DocDownloadStream not implemented")
 }
+func (UnimplementedSidecarServer) PayloadUploadStream(Sidecar_PayloadUploadStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PayloadUploadStream not implemented")
+}
+func (UnimplementedSidecarServer) PayloadDownloadStream(Sidecar_PayloadDownloadStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method PayloadDownloadStream not implemented")
+}
 func (UnimplementedSidecarServer) Recv(context.Context, *Receive) (*SubTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Recv not implemented")
 }
+func (UnimplementedSidecarServer) Subscribe(*SubMsg, Sidecar_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
 func (UnimplementedSidecarServer) RecvJS(context.Context, *ReceiveJS) (*SubJSTopicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecvJS not implemented")
 }
@@ -247,15 +604,60 @@ func (UnimplementedSidecarServer) UnsubJS(context.Context, *UnsubJSMsg) (*UnsubJ
 func (UnimplementedSidecarServer) Pub(context.Context, *PubMsg) (*PubMsgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pub not implemented")
 }
-func (UnimplementedSidecarServer) PubJS(context.Context, *PubJSMsg) (*emptypb.Empty, error) {
+func (UnimplementedSidecarServer) Request(context.Context, *RequestMsg) (*RequestMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Request not implemented")
+}
+func (UnimplementedSidecarServer) Respond(context.Context, *RespondMsg) (*RespondMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Respond not implemented")
+}
+func (UnimplementedSidecarServer) PubJS(context.Context, *PubJSMsg) (*PubJSMsgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PubJS not implemented")
 }
+func (UnimplementedSidecarServer) PubAcks(*PubAcksMsg, Sidecar_PubAcksServer) error {
+	return status.Errorf(codes.Unimplemented, "method PubAcks not implemented")
+}
 func (UnimplementedSidecarServer) Log(context.Context, *LogMsg) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Log not implemented")
 }
 func (UnimplementedSidecarServer) AddJS(context.Context, *AddJSMsg) (*AddJSMsgResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddJS not implemented")
 }
+func (UnimplementedSidecarServer) Ack(context.Context, *AckMsg) (*AckMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ack not implemented")
+}
+func (UnimplementedSidecarServer) Nak(context.Context, *AckMsg) (*AckMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nak not implemented")
+}
+func (UnimplementedSidecarServer) InProgress(context.Context, *AckMsg) (*AckMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InProgress not implemented")
+}
+func (UnimplementedSidecarServer) Term(context.Context, *AckMsg) (*AckMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Term not implemented")
+}
+func (UnimplementedSidecarServer) AddStream(context.Context, *StreamMsg) (*StreamMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStream not implemented")
+}
+func (UnimplementedSidecarServer) UpdateStream(context.Context, *StreamMsg) (*StreamMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStream not implemented")
+}
+func (UnimplementedSidecarServer) DeleteStream(context.Context, *StreamNameMsg) (*StreamMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStream not implemented")
+}
+func (UnimplementedSidecarServer) GetStreamInfo(context.Context, *StreamNameMsg) (*StreamMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreamInfo not implemented")
+}
+func (UnimplementedSidecarServer) AddConsumer(context.Context, *ConsumerMsg) (*ConsumerMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddConsumer not implemented")
+}
+func (UnimplementedSidecarServer) UpdateConsumer(context.Context, *ConsumerMsg) (*ConsumerMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConsumer not implemented")
+}
+func (UnimplementedSidecarServer) DeleteConsumer(context.Context, *ConsumerNameMsg) (*ConsumerMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConsumer not implemented")
+}
+func (UnimplementedSidecarServer) GetConsumerInfo(context.Context, *ConsumerNameMsg) (*ConsumerMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumerInfo not implemented")
+}
 func (UnimplementedSidecarServer) mustEmbedUnimplementedSidecarServer() {}
 
 // UnsafeSidecarServer may be embedded to opt out of forward compatibility for this service.
@@ -287,6 +689,60 @@ func _Sidecar_Register_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sidecar_Deregister_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregistrationMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Deregister(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/Deregister",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Deregister(ctx, req.(*DeregistrationMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_KeepAlive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeepAliveMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).KeepAlive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/KeepAlive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).KeepAlive(ctx, req.(*KeepAliveMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_Discover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Discover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/Discover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Discover(ctx, req.(*DiscoverMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sidecar_Sub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubMsg)
 	if err := dec(in); err != nil {
@@ -357,10 +813,62 @@ func (x *sidecarDocDownloadStreamServer) Recv() (*DocDownloadResponse, error) {
 	return m, nil
 }
 
-func _Sidecar_Recv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Receive)
-	if err := dec(in); err != nil {
-		return nil, err
+func _Sidecar_PayloadUploadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SidecarServer).PayloadUploadStream(&sidecarPayloadUploadStreamServer{stream})
+}
+
+type Sidecar_PayloadUploadStreamServer interface {
+	Send(*DocUploadResponse) error
+	Recv() (*PayloadUpload, error)
+	grpc.ServerStream
+}
+
+type sidecarPayloadUploadStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *sidecarPayloadUploadStreamServer) Send(m *DocUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sidecarPayloadUploadStreamServer) Recv() (*PayloadUpload, error) {
+	m := new(PayloadUpload)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Sidecar_PayloadDownloadStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SidecarServer).PayloadDownloadStream(&sidecarPayloadDownloadStreamServer{stream})
+}
+
+type Sidecar_PayloadDownloadStreamServer interface {
+	Send(*PayloadDownload) error
+	Recv() (*DocDownloadResponse, error)
+	grpc.ServerStream
+}
+
+type sidecarPayloadDownloadStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *sidecarPayloadDownloadStreamServer) Send(m *PayloadDownload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *sidecarPayloadDownloadStreamServer) Recv() (*DocDownloadResponse, error) {
+	m := new(DocDownloadResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Sidecar_Recv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Receive)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
 	if interceptor == nil {
 		return srv.(SidecarServer).Recv(ctx, in)
@@ -375,6 +883,27 @@ func _Sidecar_Recv_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sidecar_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SidecarServer).Subscribe(m, &sidecarSubscribeServer{stream})
+}
+
+type Sidecar_SubscribeServer interface {
+	Send(*SubTopicResponse) error
+	grpc.ServerStream
+}
+
+type sidecarSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *sidecarSubscribeServer) Send(m *SubTopicResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Sidecar_RecvJS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReceiveJS)
 	if err := dec(in); err != nil {
@@ -447,6 +976,42 @@ func _Sidecar_Pub_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sidecar_Request_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Request(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/Request",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Request(ctx, req.(*RequestMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_Respond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Respond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/Respond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Respond(ctx, req.(*RespondMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Sidecar_PubJS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PubJSMsg)
 	if err := dec(in); err != nil {
@@ -465,6 +1030,27 @@ func _Sidecar_PubJS_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sidecar_PubAcks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PubAcksMsg)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SidecarServer).PubAcks(m, &sidecarPubAcksServer{stream})
+}
+
+type Sidecar_PubAcksServer interface {
+	Send(*PubAckResult) error
+	grpc.ServerStream
+}
+
+type sidecarPubAcksServer struct {
+	grpc.ServerStream
+}
+
+func (x *sidecarPubAcksServer) Send(m *PubAckResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Sidecar_Log_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LogMsg)
 	if err := dec(in); err != nil {
@@ -501,6 +1087,222 @@ func _Sidecar_AddJS_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sidecar_Ack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Ack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/Ack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Ack(ctx, req.(*AckMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_Nak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Nak(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/Nak",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Nak(ctx, req.(*AckMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_InProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).InProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/InProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).InProgress(ctx, req.(*AckMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_Term_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).Term(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/Term",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).Term(ctx, req.(*AckMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_AddStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).AddStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/AddStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).AddStream(ctx, req.(*StreamMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_UpdateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).UpdateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/UpdateStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).UpdateStream(ctx, req.(*StreamMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_DeleteStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamNameMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).DeleteStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/DeleteStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).DeleteStream(ctx, req.(*StreamNameMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_GetStreamInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamNameMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).GetStreamInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/GetStreamInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).GetStreamInfo(ctx, req.(*StreamNameMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_AddConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).AddConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/AddConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).AddConsumer(ctx, req.(*ConsumerMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_UpdateConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).UpdateConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/UpdateConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).UpdateConsumer(ctx, req.(*ConsumerMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_DeleteConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerNameMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).DeleteConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/DeleteConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).DeleteConsumer(ctx, req.(*ConsumerNameMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sidecar_GetConsumerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumerNameMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarServer).GetConsumerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.Sidecar/GetConsumerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarServer).GetConsumerInfo(ctx, req.(*ConsumerNameMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sidecar_ServiceDesc is the grpc.ServiceDesc for Sidecar service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -512,6 +1314,18 @@ var Sidecar_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _Sidecar_Register_Handler,
 		},
+		{
+			MethodName: "Deregister",
+			Handler:    _Sidecar_Deregister_Handler,
+		},
+		{
+			MethodName: "KeepAlive",
+			Handler:    _Sidecar_KeepAlive_Handler,
+		},
+		{
+			MethodName: "Discover",
+			Handler:    _Sidecar_Discover_Handler,
+		},
 		{
 			MethodName: "Sub",
 			Handler:    _Sidecar_Sub_Handler,
@@ -536,6 +1350,14 @@ var Sidecar_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Pub",
 			Handler:    _Sidecar_Pub_Handler,
 		},
+		{
+			MethodName: "Request",
+			Handler:    _Sidecar_Request_Handler,
+		},
+		{
+			MethodName: "Respond",
+			Handler:    _Sidecar_Respond_Handler,
+		},
 		{
 			MethodName: "PubJS",
 			Handler:    _Sidecar_PubJS_Handler,
@@ -548,6 +1370,54 @@ var Sidecar_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddJS",
 			Handler:    _Sidecar_AddJS_Handler,
 		},
+		{
+			MethodName: "Ack",
+			Handler:    _Sidecar_Ack_Handler,
+		},
+		{
+			MethodName: "Nak",
+			Handler:    _Sidecar_Nak_Handler,
+		},
+		{
+			MethodName: "InProgress",
+			Handler:    _Sidecar_InProgress_Handler,
+		},
+		{
+			MethodName: "Term",
+			Handler:    _Sidecar_Term_Handler,
+		},
+		{
+			MethodName: "AddStream",
+			Handler:    _Sidecar_AddStream_Handler,
+		},
+		{
+			MethodName: "UpdateStream",
+			Handler:    _Sidecar_UpdateStream_Handler,
+		},
+		{
+			MethodName: "DeleteStream",
+			Handler:    _Sidecar_DeleteStream_Handler,
+		},
+		{
+			MethodName: "GetStreamInfo",
+			Handler:    _Sidecar_GetStreamInfo_Handler,
+		},
+		{
+			MethodName: "AddConsumer",
+			Handler:    _Sidecar_AddConsumer_Handler,
+		},
+		{
+			MethodName: "UpdateConsumer",
+			Handler:    _Sidecar_UpdateConsumer_Handler,
+		},
+		{
+			MethodName: "DeleteConsumer",
+			Handler:    _Sidecar_DeleteConsumer_Handler,
+		},
+		{
+			MethodName: "GetConsumerInfo",
+			Handler:    _Sidecar_GetConsumerInfo_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -562,6 +1432,28 @@ var Sidecar_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PayloadUploadStream",
+			Handler:       _Sidecar_PayloadUploadStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PayloadDownloadStream",
+			Handler:       _Sidecar_PayloadDownloadStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Sidecar_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PubAcks",
+			Handler:       _Sidecar_PubAcks_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "protos/v1/messages/sidecar.proto",
 }